@@ -5,6 +5,7 @@ package tfe
 
 import (
 	"context"
+	"time"
 )
 
 // Compile-time proof of interface implementation.
@@ -53,6 +54,31 @@ type AdminGeneralSettingsUpdateOptions struct {
 	SendPassingStatusUntriggeredPlans *bool `jsonapi:"attr,send-passing-statuses-for-untriggered-speculative-plans,omitempty"`
 	AllowSpeculativePlansOnPR         *bool `jsonapi:"attr,allow-speculative-plans-on-pull-requests-from-forks,omitempty"`
 	DefaultRemoteStateAccess          *bool `jsonapi:"attr,default-remote-state-access,omitempty"`
+	RequireTwoFactorForAdmin          *bool `jsonapi:"attr,require-two-factor-for-admins,omitempty"`
+	FairRunQueuingEnabled             *bool `jsonapi:"attr,fair-run-queuing-enabled,omitempty"`
+	LimitOrgsPerUser                  *bool `jsonapi:"attr,limit-organizations-per-user,omitempty"`
+	DefaultOrgsPerUserCeiling         *int  `jsonapi:"attr,default-organizations-per-user-ceiling,omitempty"`
+	LimitWorkspacesPerOrg             *bool `jsonapi:"attr,limit-workspaces-per-organization,omitempty"`
+	DefaultWorkspacesPerOrgCeiling    *int  `jsonapi:"attr,default-workspaces-per-organization-ceiling,omitempty"`
+
+	// Optional: Timeouts of Terraform plans and applies, as duration strings
+	// such as "2h" or "90m".
+	TerraformBuildWorkerApplyTimeout *string `jsonapi:"attr,terraform-build-worker-apply-timeout,omitempty"`
+	TerraformBuildWorkerPlanTimeout  *string `jsonapi:"attr,terraform-build-worker-plan-timeout,omitempty"`
+}
+
+// minAPIRateLimit is the lowest API rate limit, in requests per second,
+// accepted by Terraform Enterprise.
+const minAPIRateLimit = 30
+
+// ApplyTimeout returns the timeout of Terraform applies.
+func (s *AdminGeneralSetting) ApplyTimeout() (time.Duration, error) {
+	return time.ParseDuration(s.TerraformBuildWorkerApplyTimeout)
+}
+
+// PlanTimeout returns the timeout of Terraform plans.
+func (s *AdminGeneralSetting) PlanTimeout() (time.Duration, error) {
+	return time.ParseDuration(s.TerraformBuildWorkerPlanTimeout)
 }
 
 // Read returns the general settings.
@@ -73,6 +99,10 @@ func (a *adminGeneralSettings) Read(ctx context.Context) (*AdminGeneralSetting,
 
 // Update updates the general settings.
 func (a *adminGeneralSettings) Update(ctx context.Context, options AdminGeneralSettingsUpdateOptions) (*AdminGeneralSetting, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
 	req, err := a.client.NewRequest("PATCH", "admin/general-settings", &options)
 	if err != nil {
 		return nil, err
@@ -86,3 +116,29 @@ func (a *adminGeneralSettings) Update(ctx context.Context, options AdminGeneralS
 
 	return ags, nil
 }
+
+func (o AdminGeneralSettingsUpdateOptions) valid() error {
+	if o.APIRateLimit != nil && *o.APIRateLimit < minAPIRateLimit {
+		return ErrInvalidAPIRateLimit
+	}
+	if o.DefaultOrgsPerUserCeiling != nil && *o.DefaultOrgsPerUserCeiling < 0 {
+		return ErrInvalidOrgsPerUserCeiling
+	}
+	if o.DefaultWorkspacesPerOrgCeiling != nil && *o.DefaultWorkspacesPerOrgCeiling < 0 {
+		return ErrInvalidWorkspacesPerOrgCeiling
+	}
+	if o.TerraformBuildWorkerApplyTimeout != nil && !validTimeout(*o.TerraformBuildWorkerApplyTimeout) {
+		return ErrInvalidTerraformBuildWorkerTimeout
+	}
+	if o.TerraformBuildWorkerPlanTimeout != nil && !validTimeout(*o.TerraformBuildWorkerPlanTimeout) {
+		return ErrInvalidTerraformBuildWorkerTimeout
+	}
+
+	return nil
+}
+
+// validTimeout checks if the given input is a positive duration string.
+func validTimeout(v string) bool {
+	d, err := time.ParseDuration(v)
+	return err == nil && d > 0
+}
@@ -6,7 +6,9 @@ package tfe
 import (
 	"context"
 	"testing"
+	"time"
 
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -35,6 +37,11 @@ func TestAdminSettings_General_Read(t *testing.T) {
 	assert.NotNil(t, generalSettings.TerraformBuildWorkerApplyTimeout)
 	assert.NotNil(t, generalSettings.TerraformBuildWorkerPlanTimeout)
 	assert.NotNil(t, generalSettings.DefaultRemoteStateAccess)
+
+	_, err = generalSettings.ApplyTimeout()
+	assert.NoError(t, err)
+	_, err = generalSettings.PlanTimeout()
+	assert.NoError(t, err)
 }
 
 func TestAdminSettings_General_Update(t *testing.T) {
@@ -81,3 +88,90 @@ func TestAdminSettings_General_Update(t *testing.T) {
 	assert.Equal(t, origAPIRateLimit, generalSettings.APIRateLimit)
 	assert.Equal(t, origDefaultRemoteState, generalSettings.DefaultRemoteStateAccess)
 }
+
+func TestAdminGeneralSettingsUpdateOptionsValid(t *testing.T) {
+	t.Run("with valid options", func(t *testing.T) {
+		options := AdminGeneralSettingsUpdateOptions{
+			APIRateLimit:                     Int(30),
+			DefaultOrgsPerUserCeiling:        Int(0),
+			DefaultWorkspacesPerOrgCeiling:   Int(100),
+			TerraformBuildWorkerApplyTimeout: String("24h"),
+			TerraformBuildWorkerPlanTimeout:  String("90m"),
+		}
+
+		err := options.valid()
+		assert.Nil(t, err)
+	})
+
+	t.Run("with a too low API rate limit", func(t *testing.T) {
+		options := AdminGeneralSettingsUpdateOptions{
+			APIRateLimit: Int(29),
+		}
+
+		err := options.valid()
+		assert.Equal(t, err, ErrInvalidAPIRateLimit)
+	})
+
+	t.Run("with a negative organizations per user ceiling", func(t *testing.T) {
+		options := AdminGeneralSettingsUpdateOptions{
+			DefaultOrgsPerUserCeiling: Int(-1),
+		}
+
+		err := options.valid()
+		assert.Equal(t, err, ErrInvalidOrgsPerUserCeiling)
+	})
+
+	t.Run("with a negative workspaces per organization ceiling", func(t *testing.T) {
+		options := AdminGeneralSettingsUpdateOptions{
+			DefaultWorkspacesPerOrgCeiling: Int(-1),
+		}
+
+		err := options.valid()
+		assert.Equal(t, err, ErrInvalidWorkspacesPerOrgCeiling)
+	})
+
+	t.Run("with an invalid timeout", func(t *testing.T) {
+		for _, timeout := range []string{"2 hours", "0s", "-1h"} {
+			options := AdminGeneralSettingsUpdateOptions{
+				TerraformBuildWorkerPlanTimeout: String(timeout),
+			}
+
+			err := options.valid()
+			assert.Equal(t, err, ErrInvalidTerraformBuildWorkerTimeout)
+		}
+	})
+}
+
+func TestAdminGeneralSettingsUpdateOptions_Marshal(t *testing.T) {
+	opts := AdminGeneralSettingsUpdateOptions{
+		APIRateLimitingEnabled:          Bool(false),
+		APIRateLimit:                    Int(50),
+		TerraformBuildWorkerPlanTimeout: String("2h"),
+	}
+
+	reqBody, err := serializeRequestBody(&opts)
+	require.NoError(t, err)
+	req, err := retryablehttp.NewRequest("PATCH", "url", reqBody)
+	require.NoError(t, err)
+	bodyBytes, err := req.BodyBytes()
+	require.NoError(t, err)
+
+	expectedBody := `{"data":{"type":"","attributes":{"api-rate-limit":50,"api-rate-limiting-enabled":false,"terraform-build-worker-plan-timeout":"2h"}}}
+`
+	assert.Equal(t, expectedBody, string(bodyBytes))
+}
+
+func TestAdminGeneralSetting_Timeouts(t *testing.T) {
+	settings := &AdminGeneralSetting{
+		TerraformBuildWorkerApplyTimeout: "24h",
+		TerraformBuildWorkerPlanTimeout:  "2h30m",
+	}
+
+	applyTimeout, err := settings.ApplyTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, applyTimeout)
+
+	planTimeout, err := settings.PlanTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Hour+30*time.Minute, planTimeout)
+}
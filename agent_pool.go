@@ -239,11 +239,17 @@ func (s *agentPools) Update(ctx context.Context, agentPoolID string, options Age
 	return k, nil
 }
 
+// UpdateAllowedWorkspaces replaces the workspaces allowed to use an agent pool
+// that is not organization scoped. An empty list clears the allowed workspaces.
 func (s *agentPools) UpdateAllowedWorkspaces(ctx context.Context, agentPoolID string, options AgentPoolAllowedWorkspacesUpdateOptions) (*AgentPool, error) {
 	if !validStringID(&agentPoolID) {
 		return nil, ErrInvalidAgentPoolID
 	}
 
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
 	u := fmt.Sprintf("agent-pools/%s", url.QueryEscape(agentPoolID))
 	req, err := s.client.NewRequest("PATCH", u, &options)
 	if err != nil {
@@ -291,6 +297,15 @@ func (o AgentPoolUpdateOptions) valid() error {
 	return nil
 }
 
+func (o AgentPoolAllowedWorkspacesUpdateOptions) valid() error {
+	for _, ws := range o.AllowedWorkspaces {
+		if ws == nil || !validStringID(&ws.ID) {
+			return ErrInvalidWorkspaceID
+		}
+	}
+	return nil
+}
+
 func (o *AgentPoolReadOptions) valid() error {
 	return nil
 }
@@ -392,6 +392,21 @@ func TestAgentPoolsUpdateAllowedWorkspaces(t *testing.T) {
 		assert.Equal(t, "a-pool", kAfter.Name)
 		assert.Empty(t, kAfter.AllowedWorkspaces)
 	})
+
+	t.Run("with an invalid workspace ID", func(t *testing.T) {
+		kBefore, kTestCleanup := createAgentPool(t, client, orgTest)
+		defer kTestCleanup()
+
+		_, err := client.AgentPools.UpdateAllowedWorkspaces(ctx, kBefore.ID, AgentPoolAllowedWorkspacesUpdateOptions{
+			AllowedWorkspaces: []*Workspace{{ID: badIdentifier}},
+		})
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+	})
+
+	t.Run("with an invalid agent pool ID", func(t *testing.T) {
+		_, err := client.AgentPools.UpdateAllowedWorkspaces(ctx, badIdentifier, AgentPoolAllowedWorkspacesUpdateOptions{})
+		assert.Equal(t, ErrInvalidAgentPoolID, err)
+	})
 }
 
 func TestAgentPoolsDelete(t *testing.T) {
@@ -7,7 +7,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 
@@ -73,6 +77,61 @@ func TestAppliesLogs(t *testing.T) {
 	})
 }
 
+func TestAppliesLogs_Streaming(t *testing.T) {
+	const logs = "\x02Apply complete! Resources: 1 added, 0 changed, 0 destroyed.\x03"
+
+	statusReads := 0
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/applies/apply-123":
+			statusReads++
+			w.Header().Set("Content-Type", ContentTypeJSONAPI)
+			fmt.Fprintf(w, `{"data":{"id":"apply-123","type":"applies","attributes":{"status":%q,"log-read-url":%q}}}`,
+				ApplyFinished, ts.URL+"/logs")
+		case "/api/v2/applies/apply-nolog":
+			w.Header().Set("Content-Type", ContentTypeJSONAPI)
+			fmt.Fprint(w, `{"data":{"id":"apply-nolog","type":"applies","attributes":{"status":"running","log-read-url":""}}}`)
+		case "/logs":
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			if offset < len(logs) {
+				fmt.Fprint(w, logs[offset:])
+			}
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("when the apply has finished", func(t *testing.T) {
+		logReader, err := client.Applies.Logs(ctx, "apply-123")
+		require.NoError(t, err)
+
+		out, err := io.ReadAll(logReader)
+		require.NoError(t, err)
+		assert.Equal(t, "Apply complete! Resources: 1 added, 0 changed, 0 destroyed.", string(out))
+		assert.Equal(t, 2, statusReads)
+	})
+
+	t.Run("when the apply does not have a log URL", func(t *testing.T) {
+		logReader, err := client.Applies.Logs(ctx, "apply-nolog")
+		assert.Nil(t, logReader)
+		assert.EqualError(t, err, "apply apply-nolog does not have a log URL")
+	})
+
+	t.Run("with an invalid apply ID", func(t *testing.T) {
+		logReader, err := client.Applies.Logs(ctx, badIdentifier)
+		assert.Nil(t, logReader)
+		assert.Equal(t, ErrInvalidApplyID, err)
+	})
+}
+
 func TestApplies_Unmarshal(t *testing.T) {
 	data := map[string]interface{}{
 		"data": map[string]interface{}{
@@ -26,6 +26,10 @@ var _ AuditTrails = (*auditTrails)(nil)
 type AuditTrails interface {
 	// Read all the audit events in an organization.
 	List(ctx context.Context, options *AuditTrailListOptions) (*AuditTrailList, error)
+
+	// Export writes every audit event since the given time to w as newline
+	// delimited JSON, returning the timestamp of the latest event written.
+	Export(ctx context.Context, w io.Writer, options AuditTrailExportOptions) (time.Time, error)
 }
 
 // auditTrails implements AuditTrails
@@ -88,6 +92,16 @@ type AuditTrailListOptions struct {
 	*ListOptions
 }
 
+// AuditTrailExportOptions represents the options for exporting audit trails.
+type AuditTrailExportOptions struct {
+	// Optional: Exports only audit trails created after this date, such as
+	// the timestamp returned by a previous export.
+	Since time.Time
+
+	// Optional: The number of audit trails requested per page.
+	PageSize int
+}
+
 // List all the audit events in an organization.
 func (s *auditTrails) List(ctx context.Context, options *AuditTrailListOptions) (*AuditTrailList, error) {
 	u, err := s.client.baseURL.Parse("/api/v2/organization/audit-trail")
@@ -150,3 +164,43 @@ func (s *auditTrails) List(ctx context.Context, options *AuditTrailListOptions)
 
 	return atl, nil
 }
+
+// Export pages through the audit events of an organization and writes them to
+// w one per line, without holding more than a page in memory. The returned
+// timestamp is that of the latest event written, or options.Since when there
+// were none, and is returned along with any error so an export can resume.
+func (s *auditTrails) Export(ctx context.Context, w io.Writer, options AuditTrailExportOptions) (time.Time, error) {
+	last := options.Since
+
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+
+	listOptions := &AuditTrailListOptions{
+		Since: options.Since,
+		ListOptions: &ListOptions{
+			PageSize: options.PageSize,
+		},
+	}
+	for {
+		atl, err := s.List(ctx, listOptions)
+		if err != nil {
+			return last, err
+		}
+
+		for _, at := range atl.Items {
+			if err := enc.Encode(at); err != nil {
+				return last, err
+			}
+			if at.Timestamp.After(last) {
+				last = at.Timestamp
+			}
+		}
+
+		if atl.AuditTrailPagination == nil || atl.NextPage == 0 {
+			break
+		}
+		listOptions.PageNumber = atl.NextPage
+	}
+
+	return last, nil
+}
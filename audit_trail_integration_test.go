@@ -4,7 +4,13 @@
 package tfe
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -136,3 +142,100 @@ func TestAuditTrailsList(t *testing.T) {
 		}
 	})
 }
+
+func TestAuditTrailsExport(t *testing.T) {
+	skipIfEnterprise(t)
+
+	userClient := testClient(t)
+	ctx := context.Background()
+
+	org, orgCleanup := createOrganization(t, userClient)
+	t.Cleanup(orgCleanup)
+
+	auditTrailClient := testAuditTrailClient(t, userClient, org)
+
+	_, wsCleanup := createWorkspace(t, userClient, org)
+	t.Cleanup(wsCleanup)
+
+	var buf bytes.Buffer
+	last, err := auditTrailClient.AuditTrails.Export(ctx, &buf, AuditTrailExportOptions{PageSize: 1})
+	require.NoError(t, err)
+	require.NotEmpty(t, buf.String())
+
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		at := &AuditTrail{}
+		require.NoError(t, json.Unmarshal([]byte(line), at))
+		assert.False(t, at.Timestamp.After(last))
+	}
+
+	t.Run("when resuming from the last timestamp", func(t *testing.T) {
+		var resumed bytes.Buffer
+		next, err := auditTrailClient.AuditTrails.Export(ctx, &resumed, AuditTrailExportOptions{Since: last})
+		require.NoError(t, err)
+		assert.Empty(t, resumed.String())
+		assert.Equal(t, last, next)
+	})
+}
+
+func TestAuditTrailsExport_Pagination(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	failSecondPage := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(204)
+		case "/api/v2/organization/audit-trail":
+			page := r.URL.Query().Get("page[number]")
+			if page == "2" && failSecondPage {
+				w.WriteHeader(500)
+				return
+			}
+
+			next, offset := 2, 0
+			if page == "2" {
+				next, offset = 0, 2
+			}
+			fmt.Fprintf(w, `{"data":[{"id":"ae-%d","timestamp":%q},{"id":"ae-%d","timestamp":%q}],"pagination":{"next_page":%d}}`,
+				offset+1, start.Add(time.Duration(offset+1)*time.Minute).Format(time.RFC3339),
+				offset+2, start.Add(time.Duration(offset+2)*time.Minute).Format(time.RFC3339),
+				next)
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "abcd1234",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	t.Run("with every page", func(t *testing.T) {
+		var buf bytes.Buffer
+		last, err := client.AuditTrails.Export(ctx, &buf, AuditTrailExportOptions{Since: start})
+		require.NoError(t, err)
+		assert.Equal(t, start.Add(4*time.Minute), last)
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 4)
+		for i, line := range lines {
+			at := &AuditTrail{}
+			require.NoError(t, json.Unmarshal([]byte(line), at))
+			assert.Equal(t, fmt.Sprintf("ae-%d", i+1), at.ID)
+		}
+	})
+
+	t.Run("when a page fails", func(t *testing.T) {
+		failSecondPage = true
+
+		var buf bytes.Buffer
+		last, err := client.AuditTrails.Export(ctx, &buf, AuditTrailExportOptions{Since: start})
+		assert.Error(t, err)
+		assert.Equal(t, start.Add(2*time.Minute), last)
+		assert.Len(t, strings.Split(strings.TrimSpace(buf.String()), "\n"), 2)
+	})
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// batchConcurrency is how many requests the methods acting on several
+// resources at once send at the same time.
+const batchConcurrency = 10
+
+// BatchError is returned by the methods acting on several resources at once,
+// such as NotificationConfigurations.SetEnabled, when some of the resources
+// failed. The other resources are still processed.
+type BatchError struct {
+	// Errors holds the error of each resource that failed, keyed by its ID,
+	// or by the category and key of a variable, such as "env/region".
+	Errors map[string]error
+
+	action   string
+	resource string
+}
+
+func (e *BatchError) Error() string {
+	keys := make([]string, 0, len(e.Errors))
+	for key := range e.Errors {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	msgs := make([]string, len(keys))
+	for i, key := range keys {
+		msgs[i] = fmt.Sprintf("%s: %s", key, e.Errors[key])
+	}
+
+	return fmt.Sprintf("failed to %s %d %s(s): %s", e.action, len(keys), e.resource, strings.Join(msgs, "; "))
+}
+
+// doBatch calls fn with the index of every key, batchConcurrency at a time.
+// It returns a *BatchError holding the errors fn returned, keyed by the key
+// at their index, or nil when fn returned none. The action and resource
+// describe what failed in the message of the error, e.g. "delete" and
+// "comment".
+func doBatch(keys []string, action, resource string, fn func(i int) error) error {
+	failed := make(map[string]error)
+	var mu sync.Mutex
+
+	var g errgroup.Group
+	g.SetLimit(batchConcurrency)
+	for i := range keys {
+		i := i
+		g.Go(func() error {
+			if err := fn(i); err != nil {
+				mu.Lock()
+				failed[keys[i]] = err
+				mu.Unlock()
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	if len(failed) > 0 {
+		return &BatchError{Errors: failed, action: action, resource: resource}
+	}
+
+	return nil
+}
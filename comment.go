@@ -5,7 +5,9 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 )
 
@@ -26,6 +28,18 @@ type Comments interface {
 
 	// Create a new comment with the given options.
 	Create(ctx context.Context, runID string, options CommentCreateOptions) (*Comment, error)
+
+	// Update the body of a comment. Returns ErrUnauthorized when the token
+	// is not permitted to edit the comment.
+	Update(ctx context.Context, commentID string, options CommentUpdateOptions) (*Comment, error)
+
+	// Delete a comment by its ID. Returns ErrUnauthorized when the token
+	// is not permitted to delete the comment.
+	Delete(ctx context.Context, commentID string) error
+
+	// DeleteAllForRun deletes all comments of a run the token is permitted
+	// to delete, and returns how many were deleted.
+	DeleteAllForRun(ctx context.Context, runID string) (int, error)
 }
 
 // Comments implements Comments.
@@ -56,6 +70,17 @@ type CommentCreateOptions struct {
 	Body string `jsonapi:"attr,body"`
 }
 
+type CommentUpdateOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,comments"`
+
+	// Required: New body of the comment.
+	Body string `jsonapi:"attr,body"`
+}
+
 // List all comments of the given run.
 func (s *comments) List(ctx context.Context, runID string) (*CommentList, error) {
 	if !validStringID(&runID) {
@@ -123,6 +148,117 @@ func (s *comments) Read(ctx context.Context, commentID string) (*Comment, error)
 	return comm, nil
 }
 
+// Update the body of a comment.
+func (s *comments) Update(ctx context.Context, commentID string, options CommentUpdateOptions) (*Comment, error) {
+	if !validStringID(&commentID) {
+		return nil, ErrInvalidCommentID
+	}
+
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("comments/%s", url.QueryEscape(commentID))
+	req, err := s.client.NewRequest("PATCH", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	var status int
+	comm := &Comment{}
+	err = req.Do(contextWithStatusCode(ctx, &status), comm)
+	if err != nil {
+		return nil, commentError(status, err)
+	}
+
+	return comm, nil
+}
+
+// Delete a comment by its ID.
+func (s *comments) Delete(ctx context.Context, commentID string) error {
+	if !validStringID(&commentID) {
+		return ErrInvalidCommentID
+	}
+
+	u := fmt.Sprintf("comments/%s", url.QueryEscape(commentID))
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	var status int
+	err = req.Do(contextWithStatusCode(ctx, &status), nil)
+	if err != nil {
+		return commentError(status, err)
+	}
+
+	return nil
+}
+
+// commentError maps the error of a request changing a comment. Comments can
+// only be changed by their author, which the API reports as forbidden.
+func commentError(status int, err error) error {
+	if status == http.StatusForbidden {
+		return ErrUnauthorized
+	}
+	return err
+}
+
+// DeleteAllForRun deletes the comments of a run concurrently, once every
+// page of them is listed. Comments the token is not permitted to delete, and
+// comments already deleted, are skipped. When other comments fail to be
+// deleted, the remaining comments are still deleted and a *BatchError keyed
+// by comment ID is returned along with the number of comments that were.
+func (s *comments) DeleteAllForRun(ctx context.Context, runID string) (int, error) {
+	if !validStringID(&runID) {
+		return 0, ErrInvalidRunID
+	}
+
+	var ids []string
+	u := fmt.Sprintf("runs/%s/comments", url.QueryEscape(runID))
+	options := &ListOptions{}
+	for {
+		req, err := s.client.NewRequest("GET", u, options)
+		if err != nil {
+			return 0, err
+		}
+
+		cl := &CommentList{}
+		err = req.Do(ctx, cl)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, c := range cl.Items {
+			ids = append(ids, c.ID)
+		}
+
+		if cl.Pagination == nil || cl.NextPage == 0 {
+			break
+		}
+		options.PageNumber = cl.NextPage
+	}
+
+	deleted := make([]bool, len(ids))
+	err := doBatch(ids, "delete", "comment", func(i int) error {
+		err := s.Delete(ctx, ids[i])
+		if errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrResourceNotFound) {
+			return nil
+		}
+		deleted[i] = err == nil
+		return err
+	})
+
+	n := 0
+	for _, ok := range deleted {
+		if ok {
+			n++
+		}
+	}
+
+	return n, err
+}
+
 func (o CommentCreateOptions) valid() error {
 	if !validString(&o.Body) {
 		return ErrInvalidCommentBody
@@ -130,3 +266,11 @@ func (o CommentCreateOptions) valid() error {
 
 	return nil
 }
+
+func (o CommentUpdateOptions) valid() error {
+	if !validString(&o.Body) {
+		return ErrInvalidCommentBody
+	}
+
+	return nil
+}
@@ -5,6 +5,11 @@ package tfe
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -64,6 +69,202 @@ func TestCommentsList(t *testing.T) {
 	})
 }
 
+func TestCommentsUpdate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	wTest, wTestCleanup := createWorkspace(t, client, orgTest)
+	defer wTestCleanup()
+
+	rTest, rTestCleanup := createRun(t, client, wTest)
+	defer rTestCleanup()
+
+	cTest, err := client.Comments.Create(ctx, rTest.ID, CommentCreateOptions{
+		Body: "status: pending",
+	})
+	require.NoError(t, err)
+
+	t.Run("with a new body", func(t *testing.T) {
+		c, err := client.Comments.Update(ctx, cTest.ID, CommentUpdateOptions{
+			Body: "status: done",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, cTest.ID, c.ID)
+		assert.Equal(t, "status: done", c.Body)
+
+		c, err = client.Comments.Read(ctx, cTest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "status: done", c.Body)
+	})
+
+	t.Run("without a body", func(t *testing.T) {
+		c, err := client.Comments.Update(ctx, cTest.ID, CommentUpdateOptions{})
+		assert.Nil(t, c)
+		assert.EqualError(t, err, ErrInvalidCommentBody.Error())
+	})
+
+	t.Run("without a valid comment ID", func(t *testing.T) {
+		c, err := client.Comments.Update(ctx, badIdentifier, CommentUpdateOptions{
+			Body: "status: done",
+		})
+		assert.Nil(t, c)
+		assert.EqualError(t, err, ErrInvalidCommentID.Error())
+	})
+}
+
+func TestCommentsDelete(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	wTest, wTestCleanup := createWorkspace(t, client, orgTest)
+	defer wTestCleanup()
+
+	rTest, rTestCleanup := createRun(t, client, wTest)
+	defer rTestCleanup()
+
+	cTest, err := client.Comments.Create(ctx, rTest.ID, CommentCreateOptions{
+		Body: "status: pending",
+	})
+	require.NoError(t, err)
+
+	t.Run("with a valid comment ID", func(t *testing.T) {
+		err := client.Comments.Delete(ctx, cTest.ID)
+		require.NoError(t, err)
+
+		_, err = client.Comments.Read(ctx, cTest.ID)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without a valid comment ID", func(t *testing.T) {
+		err := client.Comments.Delete(ctx, badIdentifier)
+		assert.EqualError(t, err, ErrInvalidCommentID.Error())
+	})
+}
+
+func TestComments_Forbidden(t *testing.T) {
+	ctx := context.Background()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(204)
+		case "/api/v2/comments/wsc-1":
+			w.Header().Set("Content-Type", ContentTypeJSONAPI)
+			w.WriteHeader(403)
+			w.Write([]byte(`{"errors":[{"status":"403","title":"forbidden"}]}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "abcd1234",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	t.Run("when updating a comment of another user", func(t *testing.T) {
+		c, err := client.Comments.Update(ctx, "wsc-1", CommentUpdateOptions{
+			Body: "status: done",
+		})
+		assert.Nil(t, c)
+		assert.Equal(t, ErrUnauthorized, err)
+	})
+
+	t.Run("when deleting a comment of another user", func(t *testing.T) {
+		err := client.Comments.Delete(ctx, "wsc-1")
+		assert.Equal(t, ErrUnauthorized, err)
+	})
+}
+
+func TestCommentsDeleteAllForRun(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	var deleted []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch {
+		case r.URL.Path == "/api/v2/ping":
+			w.WriteHeader(204)
+		case r.Method == "GET" && r.URL.Path == "/api/v2/runs/run-123/comments" && r.URL.Query().Get("page[number]") == "2":
+			w.Write([]byte(`{"data":[
+				{"id":"wsc-4","type":"comments","attributes":{"body":"status: failed"}},
+				{"id":"wsc-5","type":"comments","attributes":{"body":"status: done"}}
+			],"meta":{"pagination":{"current-page":2,"prev-page":1,"next-page":null,"total-pages":2,"total-count":5}}}`))
+		case r.Method == "GET" && r.URL.Path == "/api/v2/runs/run-123/comments":
+			w.Write([]byte(`{"data":[
+				{"id":"wsc-1","type":"comments","attributes":{"body":"status: pending"}},
+				{"id":"wsc-2","type":"comments","attributes":{"body":"looks good"}},
+				{"id":"wsc-3","type":"comments","attributes":{"body":"status: running"}}
+			],"meta":{"pagination":{"current-page":1,"prev-page":null,"next-page":2,"total-pages":2,"total-count":5}}}`))
+		case r.Method == "GET" && r.URL.Path == "/api/v2/runs/run-empty/comments":
+			w.Write([]byte(`{"data":[]}`))
+		case r.Method == "DELETE" && r.URL.Path == "/api/v2/comments/wsc-2":
+			w.WriteHeader(403)
+			w.Write([]byte(`{"errors":[{"status":"403","title":"forbidden"}]}`))
+		case r.Method == "DELETE" && r.URL.Path == "/api/v2/comments/wsc-3":
+			w.WriteHeader(404)
+		case r.Method == "DELETE" && r.URL.Path == "/api/v2/comments/wsc-4":
+			w.WriteHeader(422)
+			w.Write([]byte(`{"errors":[{"status":"422","title":"invalid","detail":"comment is locked"}]}`))
+		case r.Method == "DELETE":
+			mu.Lock()
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/api/v2/comments/"))
+			mu.Unlock()
+			w.WriteHeader(204)
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "abcd1234",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	t.Run("with several pages of comments, some that cannot be deleted", func(t *testing.T) {
+		n, err := client.Comments.DeleteAllForRun(ctx, "run-123")
+		assert.Equal(t, 2, n)
+		assert.ElementsMatch(t, []string{"wsc-1", "wsc-5"}, deleted)
+
+		var deleteErr *BatchError
+		require.True(t, errors.As(err, &deleteErr))
+		require.Len(t, deleteErr.Errors, 1)
+		assert.Contains(t, deleteErr.Errors, "wsc-4")
+		assert.EqualError(t, err, "failed to delete 1 comment(s): wsc-4: invalid\n\ncomment is locked")
+	})
+
+	t.Run("without comments", func(t *testing.T) {
+		n, err := client.Comments.DeleteAllForRun(ctx, "run-empty")
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("when the run does not exist", func(t *testing.T) {
+		n, err := client.Comments.DeleteAllForRun(ctx, "run-nonexisting")
+		assert.Equal(t, 0, n)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without a valid run ID", func(t *testing.T) {
+		n, err := client.Comments.DeleteAllForRun(ctx, badIdentifier)
+		assert.Equal(t, 0, n)
+		assert.EqualError(t, err, ErrInvalidRunID.Error())
+	})
+}
+
 func commentItemsContainsBody(items []*Comment, body string) bool {
 	hasBody := false
 	for _, item := range items {
@@ -67,6 +67,10 @@ type ConfigurationVersions interface {
 	// PermanentlyDeleteBackingData permanently deletes a soft deleted configuration version's backing data
 	// **Note: This functionality is only available in Terraform Enterprise.**
 	PermanentlyDeleteBackingData(ctx context.Context, svID string) error
+
+	// WaitForStatus polls a configuration version until it reaches one of
+	// the given statuses, or ConfigurationUploaded when none are given.
+	WaitForStatus(ctx context.Context, cvID string, options *WaitOptions, statuses ...ConfigurationStatus) (*ConfigurationVersion, error)
 }
 
 // configurationVersions implements ConfigurationVersions.
@@ -300,6 +304,63 @@ func (s *configurationVersions) ReadWithOptions(ctx context.Context, cvID string
 	return cv, nil
 }
 
+// WaitForStatus reads the configuration version until its status is one of
+// statuses. The delay between reads follows DefaultWaitBackoff, unless
+// options sets a poll interval or backoff.
+// When the configuration version errors, ErrConfigurationVersionErrored is
+// returned along with the reason reported by the API. When it ends in another
+// terminal status that was not requested,
+// ErrConfigurationVersionStatusNotReached is returned. The last configuration
+// version read is returned along with any error.
+func (s *configurationVersions) WaitForStatus(ctx context.Context, cvID string, options *WaitOptions, statuses ...ConfigurationStatus) (*ConfigurationVersion, error) {
+	if !validStringID(&cvID) {
+		return nil, ErrInvalidConfigVersionID
+	}
+
+	if len(statuses) == 0 {
+		statuses = []ConfigurationStatus{ConfigurationUploaded}
+	}
+
+	var cv *ConfigurationVersion
+	err := wait(ctx, withDefaultBackoff(options), func() (bool, error) {
+		current, err := s.Read(ctx, cvID)
+		if err != nil {
+			return false, err
+		}
+		cv = current
+
+		for _, status := range statuses {
+			if cv.Status == status {
+				return true, nil
+			}
+		}
+
+		switch cv.Status {
+		case ConfigurationErrored:
+			return false, fmt.Errorf("%w: %s", ErrConfigurationVersionErrored, cv.errorReason())
+		case ConfigurationArchived, ConfigurationUploaded:
+			return false, fmt.Errorf("%w: %s", ErrConfigurationVersionStatusNotReached, cv.Status)
+		default:
+			return false, nil
+		}
+	})
+
+	return cv, err
+}
+
+// errorReason returns why the configuration version errored, preferring the
+// human readable message over the error code.
+func (cv *ConfigurationVersion) errorReason() string {
+	switch {
+	case cv.ErrorMessage != "":
+		return cv.ErrorMessage
+	case cv.Error != "":
+		return cv.Error
+	default:
+		return "no reason given"
+	}
+}
+
 // Upload packages and uploads Terraform configuration files. It requires the
 // upload URL from a configuration version and the path to the configuration
 // files on disk.
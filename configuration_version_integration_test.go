@@ -8,6 +8,9 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
@@ -281,6 +284,12 @@ func TestConfigurationVersionsUpload(t *testing.T) {
 		WaitUntilStatus(t, client, cv, ConfigurationUploaded, 60)
 	})
 
+	t.Run("when waiting for the upload", func(t *testing.T) {
+		uploaded, err := client.ConfigurationVersions.WaitForStatus(ctx, cv.ID, &WaitOptions{MaxWait: time.Minute})
+		require.NoError(t, err)
+		assert.Equal(t, ConfigurationUploaded, uploaded.Status)
+	})
+
 	t.Run("without a valid upload URL", func(t *testing.T) {
 		err := client.ConfigurationVersions.Upload(
 			ctx,
@@ -428,6 +437,70 @@ func TestConfigurationVersionsDownload(t *testing.T) {
 	})
 }
 
+func TestConfigurationVersionsWaitForStatus_Polling(t *testing.T) {
+	ctx := context.Background()
+
+	var responses []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		attributes := responses[0]
+		if len(responses) > 1 {
+			responses = responses[1:]
+		}
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		fmt.Fprintf(w, `{"data":{"id":"cv-123","type":"configuration-versions","attributes":%s}}`, attributes)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+
+	options := &WaitOptions{PollInterval: time.Millisecond}
+
+	t.Run("when the upload is processed", func(t *testing.T) {
+		responses = []string{`{"status":"pending"}`, `{"status":"fetching"}`, `{"status":"uploaded"}`}
+		cv, err := client.ConfigurationVersions.WaitForStatus(ctx, "cv-123", options)
+		require.NoError(t, err)
+		assert.Equal(t, ConfigurationUploaded, cv.Status)
+	})
+
+	t.Run("when the upload errors", func(t *testing.T) {
+		responses = []string{
+			`{"status":"pending"}`,
+			`{"status":"errored","error":"invalid_archive","error-message":"The uploaded file is not a valid tar.gz archive"}`,
+		}
+		cv, err := client.ConfigurationVersions.WaitForStatus(ctx, "cv-123", options)
+		assert.ErrorIs(t, err, ErrConfigurationVersionErrored)
+		assert.EqualError(t, err, "configuration version errored: The uploaded file is not a valid tar.gz archive")
+		require.NotNil(t, cv)
+		assert.Equal(t, "invalid_archive", cv.Error)
+	})
+
+	t.Run("when the upload errors without a message", func(t *testing.T) {
+		responses = []string{`{"status":"errored","error":"invalid_archive"}`}
+		_, err := client.ConfigurationVersions.WaitForStatus(ctx, "cv-123", options)
+		assert.EqualError(t, err, "configuration version errored: invalid_archive")
+	})
+
+	t.Run("when the configuration version ends in another status", func(t *testing.T) {
+		responses = []string{`{"status":"archived"}`}
+		cv, err := client.ConfigurationVersions.WaitForStatus(ctx, "cv-123", options)
+		assert.ErrorIs(t, err, ErrConfigurationVersionStatusNotReached)
+		require.NotNil(t, cv)
+		assert.Equal(t, ConfigurationArchived, cv.Status)
+	})
+
+	t.Run("with an invalid configuration version ID", func(t *testing.T) {
+		cv, err := client.ConfigurationVersions.WaitForStatus(ctx, badIdentifier, options)
+		assert.Nil(t, cv)
+		assert.Equal(t, ErrInvalidConfigVersionID, err)
+	})
+}
+
 func TestConfigurationVersions_Unmarshal(t *testing.T) {
 	data := map[string]interface{}{
 		"data": map[string]interface{}{
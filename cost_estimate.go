@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"strconv"
 	"time"
 )
 
@@ -59,6 +60,39 @@ type CostEstimate struct {
 	UnmatchedResourcesCount int                           `jsonapi:"attr,unmatched-resources-count"`
 }
 
+// CostEstimateMonthlyCosts holds the monthly costs of a cost estimate, in US
+// dollars.
+type CostEstimateMonthlyCosts struct {
+	Proposed float64 // Monthly cost of the resources once the run is applied
+	Prior    float64 // Monthly cost of the resources before the run
+	Delta    float64 // Difference between the proposed and prior monthly costs
+}
+
+// MonthlyCosts parses the monthly costs of the cost estimate, which the API
+// returns as decimal strings. Costs that are not set yet are zero.
+func (ce *CostEstimate) MonthlyCosts() (CostEstimateMonthlyCosts, error) {
+	var costs CostEstimateMonthlyCosts
+	for _, c := range []struct {
+		value string
+		cost  *float64
+	}{
+		{ce.ProposedMonthlyCost, &costs.Proposed},
+		{ce.PriorMonthlyCost, &costs.Prior},
+		{ce.DeltaMonthlyCost, &costs.Delta},
+	} {
+		if c.value == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(c.value, 64)
+		if err != nil {
+			return CostEstimateMonthlyCosts{}, fmt.Errorf("invalid monthly cost %q: %w", c.value, err)
+		}
+		*c.cost = v
+	}
+
+	return costs, nil
+}
+
 // CostEstimateStatusTimestamps holds the timestamps for individual costEstimate statuses.
 type CostEstimateStatusTimestamps struct {
 	CanceledAt              time.Time `jsonapi:"attr,canceled-at,rfc3339"`
@@ -96,38 +130,33 @@ func (s *costEstimates) Logs(ctx context.Context, costEstimateID string) (io.Rea
 		return nil, ErrInvalidCostEstimateID
 	}
 
-	// Loop until the context is canceled or the cost estimate is finished
+	// Wait until the context is canceled or the cost estimate is finished
 	// running. The cost estimate logs are not streamed and so only available
 	// once the estimate is finished.
-	for {
+	err := wait(ctx, &WaitOptions{PollInterval: time.Second}, func() (bool, error) {
 		// Get the costEstimate to make sure it exists.
 		ce, err := s.Read(ctx, costEstimateID)
 		if err != nil {
-			return nil, err
-		}
-
-		switch ce.Status {
-		case CostEstimateQueued:
-			select {
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			case <-time.After(1000 * time.Millisecond):
-				continue
-			}
+			return false, err
 		}
 
-		u := fmt.Sprintf("cost-estimates/%s/output", url.QueryEscape(costEstimateID))
-		req, err := s.client.NewRequest("GET", u, nil)
-		if err != nil {
-			return nil, err
-		}
+		return ce.Status != CostEstimateQueued, nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		logs := bytes.NewBuffer(nil)
-		err = req.Do(ctx, logs)
-		if err != nil {
-			return nil, err
-		}
+	u := fmt.Sprintf("cost-estimates/%s/output", url.QueryEscape(costEstimateID))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
 
-		return logs, nil
+	logs := bytes.NewBuffer(nil)
+	err = req.Do(ctx, logs)
+	if err != nil {
+		return nil, err
 	}
+
+	return logs, nil
 }
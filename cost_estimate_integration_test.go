@@ -102,3 +102,23 @@ func TestCostEsimate_Unmarshal(t *testing.T) {
 	assert.Equal(t, ce.StatusTimestamps.QueuedAt, queuedParsedTime)
 	assert.Equal(t, ce.StatusTimestamps.ErroredAt, erroredParsedTime)
 }
+
+func TestCostEstimate_MonthlyCosts(t *testing.T) {
+	t.Run("with all costs set", func(t *testing.T) {
+		ce := &CostEstimate{ProposedMonthlyCost: "5.00", PriorMonthlyCost: "7.50", DeltaMonthlyCost: "-2.50"}
+		costs, err := ce.MonthlyCosts()
+		require.NoError(t, err)
+		assert.Equal(t, CostEstimateMonthlyCosts{Proposed: 5, Prior: 7.5, Delta: -2.5}, costs)
+	})
+
+	t.Run("when the costs are not set yet", func(t *testing.T) {
+		costs, err := (&CostEstimate{}).MonthlyCosts()
+		require.NoError(t, err)
+		assert.Equal(t, CostEstimateMonthlyCosts{}, costs)
+	})
+
+	t.Run("with an invalid cost", func(t *testing.T) {
+		_, err := (&CostEstimate{ProposedMonthlyCost: "n/a"}).MonthlyCosts()
+		assert.Error(t, err)
+	})
+}
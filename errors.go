@@ -5,6 +5,7 @@ package tfe
 
 import (
 	"errors"
+	"fmt"
 )
 
 // Generic errors applicable to all resources.
@@ -21,6 +22,25 @@ var (
 	// ErrNamespaceNotAuthorized is returned when a user attempts to perform an action
 	// on a namespace (organization) they do not have access to.
 	ErrNamespaceNotAuthorized = errors.New("namespace not authorized")
+
+	// ErrWaitTimeout is returned when polling a resource takes longer than the
+	// maximum wait time.
+	ErrWaitTimeout = errors.New("timed out waiting for resource")
+
+	// ErrNotModified is returned when a read is made with an entity tag that
+	// still matches the resource, so the cached copy can be kept.
+	ErrNotModified = errors.New("resource not modified")
+
+	// ErrNoRedirect is returned when reading the URL a download redirects to,
+	// but the API responds with the data instead of a redirect.
+	ErrNoRedirect = errors.New("response is not a redirect")
+
+	// ErrNotSupported is returned for operations the API does not support.
+	ErrNotSupported = errors.New("operation not supported by the API")
+
+	// ErrVersionNotFound is returned when log output does not contain the
+	// Terraform version banner.
+	ErrVersionNotFound = errors.New("terraform version not found in log")
 )
 
 // Options/fields that cannot be defined
@@ -40,6 +60,10 @@ var (
 	ErrUnsupportedBothTriggerPatternsAndPrefixes = errors.New(`"TriggerPatterns" and "TriggerPrefixes" cannot be populated at the same time`)
 
 	ErrUnsupportedBothNamespaceAndPrivateRegistryName = errors.New(`"Namespace" cannot be populated when "RegistryName" is "private"`)
+
+	ErrUnsupportedBothOauthTokenAndGithubAppInstallationID = errors.New(`"OAuthTokenID" and "GHAInstallationID" cannot be populated at the same time`)
+
+	ErrUnsupportedCustomPermissions = errors.New(`"ProjectAccess" and "WorkspaceAccess" can only be populated when "Access" is "custom"`)
 )
 
 // Library errors that usually indicate a bug in the implementation of go-tfe
@@ -78,6 +102,96 @@ var (
 	// it is locked. "conflict" followed by newline is used to preserve go-tfe version
 	// compatibility with the error constructed at runtime before it was defined here.
 	ErrWorkspaceLockedCannotDelete = errors.New("conflict\nWorkspace is currently locked. Workspace must be unlocked before it can be safely deleted")
+
+	// ErrWorkspaceDestroyPlanNotAllowed is returned when creating a destroy run
+	// on a workspace that does not allow destroy plans.
+	ErrWorkspaceDestroyPlanNotAllowed = errors.New("workspace does not allow destroy plans")
+
+	// ErrNoCurrentRun is returned when reading the current run of a workspace
+	// that has no runs.
+	ErrNoCurrentRun = errors.New("workspace has no current run")
+
+	// ErrRunNotErrored is returned when retrying a run that has not errored.
+	ErrRunNotErrored = errors.New("only errored runs can be retried")
+
+	// ErrStateVersionNotUploaded is returned when reading the download URL of
+	// a state version whose state has not been uploaded.
+	ErrStateVersionNotUploaded = errors.New("state version has no uploaded state")
+
+	// ErrWorkspaceNotVCSBacked is returned when creating a speculative run from
+	// VCS on a workspace without a VCS connection.
+	ErrWorkspaceNotVCSBacked = errors.New("workspace is not connected to a VCS repository")
+
+	// ErrVCSRefNotFound is returned when no configuration version of a
+	// workspace was ingressed from the requested branch or commit.
+	ErrVCSRefNotFound = errors.New("no configuration version found for the VCS branch or commit")
+
+	// ErrWorkspaceTransferNotSupported is returned when moving a workspace to
+	// another organization, which the API does not support.
+	ErrWorkspaceTransferNotSupported = fmt.Errorf("%w: workspaces cannot be moved between organizations, "+
+		"create the workspace in the target organization and copy its variables and state instead", ErrNotSupported)
+
+	// ErrModuleUsageNotSupported is returned when listing the workspaces that
+	// use a registry module.
+	ErrModuleUsageNotSupported = fmt.Errorf("%w: the API does not report which workspaces use a registry module, "+
+		"inspect the configuration versions of the workspaces instead", ErrNotSupported)
+
+	// ErrRunTaskStageDefaultsNotSupported is returned when updating the run
+	// task stage defaults of an organization.
+	ErrRunTaskStageDefaultsNotSupported = fmt.Errorf("%w: organizations have no defaults for workspace run tasks, "+
+		"set the stage and enforcement level of each workspace run task or use a global run task instead", ErrNotSupported)
+
+	// ErrTerraformVersionsNotSupported is returned when listing the Terraform
+	// versions available to an organization.
+	ErrTerraformVersionsNotSupported = fmt.Errorf("%w: the Terraform versions of an organization can only be listed "+
+		"through the admin Terraform versions endpoint", ErrNotSupported)
+
+	// ErrRunHasNoPlan is returned when reading or exporting the plan of a run
+	// that has no plan yet.
+	ErrRunHasNoPlan = errors.New("run has no plan")
+
+	// ErrPlanExportNotFinished is returned when a plan export is canceled,
+	// errors or expires before it finishes.
+	ErrPlanExportNotFinished = errors.New("plan export did not finish")
+
+	// ErrPlanExportTypeNotFound is returned when reading the export of a plan
+	// that has no export of the requested data type.
+	ErrPlanExportTypeNotFound = errors.New("plan has no export of the requested data type")
+
+	// ErrPlanExportNotReady is returned when reading the export of a plan
+	// that is still pending and waiting was not requested.
+	ErrPlanExportNotReady = errors.New("plan export is not finished yet")
+
+	// ErrCostEstimationDisabled is returned when reading the cost estimate of
+	// a plan whose run was not cost estimated.
+	ErrCostEstimationDisabled = errors.New("cost estimation is not enabled for the plan's workspace")
+
+	// ErrConfigurationVersionErrored is returned when waiting for a
+	// configuration version that failed to upload or be processed.
+	ErrConfigurationVersionErrored = errors.New("configuration version errored")
+
+	// ErrConfigurationVersionStatusNotReached is returned when waiting for a
+	// configuration version status and the configuration version ends in
+	// another terminal status instead.
+	ErrConfigurationVersionStatusNotReached = errors.New("configuration version ended without reaching the requested status")
+
+	// ErrPlanStatusNotReached is returned when waiting for a plan status and
+	// the plan ends in another terminal status instead.
+	ErrPlanStatusNotReached = errors.New("plan ended without reaching the requested status")
+
+	// ErrPlanCancelPending is returned when canceling a plan waiting for MFA
+	// whose run can not be force-canceled yet. Its run has been canceled, and
+	// can be force-canceled once Run.Actions.IsForceCancelable is set, from
+	// Run.ForceCancelAvailableAt.
+	ErrPlanCancelPending = errors.New("plan is waiting for MFA and its run can not be force-canceled yet")
+
+	// ErrPlanJSONUnauthorized is returned when the token is not allowed to
+	// read the JSON execution plan of a plan.
+	ErrPlanJSONUnauthorized = errors.New("not authorized to read the JSON execution plan")
+
+	// ErrPlanJSONNotReady is returned when reading the JSON execution plan of
+	// a plan that has not finished yet.
+	ErrPlanJSONNotReady = errors.New("JSON execution plan is not available until the plan finishes")
 )
 
 // Invalid values for resources/struct fields
@@ -100,6 +214,18 @@ var (
 
 	ErrInvalidSMTPAuth = errors.New("invalid smtp auth type")
 
+	ErrInvalidAPIRateLimit = errors.New("invalid value for API rate limit, must be at least 30 requests per second")
+
+	ErrInvalidOrgsPerUserCeiling = errors.New("invalid value for organizations per user ceiling, must not be negative")
+
+	ErrInvalidWorkspacesPerOrgCeiling = errors.New("invalid value for workspaces per organization ceiling, must not be negative")
+
+	ErrInvalidTerraformBuildWorkerTimeout = errors.New(`invalid value for Terraform build worker timeout, must be a positive duration such as "2h"`)
+
+	ErrInvalidWatchInterval = errors.New("invalid value for watch interval, must be a positive duration")
+
+	ErrInvalidPollInterval = errors.New("invalid value for poll interval, must not be a negative duration")
+
 	ErrInvalidAgentPoolID = errors.New("invalid value for agent pool ID")
 
 	ErrInvalidAgentTokenID = errors.New("invalid value for agent token ID")
@@ -108,8 +234,16 @@ var (
 
 	ErrInvalidRunEventID = errors.New("invalid value for run event ID")
 
+	ErrInvalidIdempotencyKey = errors.New("invalid value for idempotency key")
+
+	ErrInvalidRunStatisticsDays = errors.New("invalid value for run statistics days")
+
 	ErrInvalidProjectID = errors.New("invalid value for project ID")
 
+	ErrInvalidTagBindingKey = errors.New("invalid value for tag binding key, must be between 1 and 128 characters")
+
+	ErrInvalidTagBindingValue = errors.New("invalid value for tag binding value, must be at most 256 characters")
+
 	ErrInvalidPagination = errors.New("invalid value for page size or number")
 
 	ErrInvalidRunTaskCategory = errors.New(`category must be "task"`)
@@ -118,6 +252,10 @@ var (
 
 	ErrInvalidRunTaskURL = errors.New("invalid url for run task URL")
 
+	ErrInvalidRunTaskStage = errors.New(`invalid value for run task stage, must be one of "pre_plan", "post_plan" or "pre_apply"`)
+
+	ErrInvalidTaskEnforcementLevel = errors.New(`invalid value for enforcement level, must be "advisory" or "mandatory"`)
+
 	ErrInvalidWorkspaceRunTaskID = errors.New("invalid value for workspace run task ID")
 
 	ErrInvalidWorkspaceRunTaskType = errors.New(`invalid value for type, please use "workspace-tasks"`)
@@ -132,6 +270,16 @@ var (
 
 	ErrInvalidName = errors.New("invalid value for name")
 
+	ErrInvalidSessionRemember = errors.New("invalid value for session remember, must be between 20 and 20160 minutes")
+
+	ErrInvalidSessionTimeout = errors.New("invalid value for session timeout, must be between 20 and 20160 minutes")
+
+	ErrInvalidCollaboratorAuthPolicy = errors.New(`invalid value for collaborator auth policy, must be "password" or "two_factor_mandatory"`)
+
+	ErrInvalidDefaultExecutionMode = errors.New(`invalid value for default execution mode, must be "remote", "local" or "agent"`)
+
+	ErrInvalidOwnersTeamSAMLRoleID = errors.New("invalid value for owners team SAML role ID")
+
 	ErrInvalidNotificationConfigID = errors.New("invalid value for notification configuration ID")
 
 	ErrInvalidMembership = errors.New("invalid value for membership")
@@ -170,6 +318,10 @@ var (
 
 	ErrInvalidIncludeValue = errors.New(`invalid value for "include" field`)
 
+	ErrInvalidAppName = errors.New("invalid value for app name")
+
+	ErrInvalidAppVersion = errors.New("invalid value for app version")
+
 	ErrInvalidSHHKeyID = errors.New("invalid value for SSH key ID")
 
 	ErrInvalidStateVerID = errors.New("invalid value for state version ID")
@@ -184,6 +336,8 @@ var (
 
 	ErrInvalidTeamID = errors.New("invalid value for team ID")
 
+	ErrDuplicateTeamAccessSpec = errors.New("team access can only be specified once per team")
+
 	ErrInvalidUsernames = errors.New("invalid value for usernames")
 
 	ErrInvalidUserID = errors.New("invalid value for user ID")
@@ -198,6 +352,12 @@ var (
 
 	ErrInvalidVariableID = errors.New("invalid value for variable ID")
 
+	ErrInvalidTfvarsCategory = errors.New(`category must be "terraform" or "env"`)
+
+	ErrInvalidHCLValue = errors.New("invalid value for HCL variable, must be a valid HCL expression")
+
+	ErrDuplicateVariableKey = errors.New("duplicate variable key")
+
 	ErrInvalidNotificationTrigger = errors.New("invalid value for notification trigger")
 
 	ErrInvalidVariableSetID = errors.New("invalid variable set ID")
@@ -235,10 +395,14 @@ var (
 
 	ErrRequiredDataType = errors.New("data type is required")
 
+	ErrRequiredDescription = errors.New("description is required")
+
 	ErrRequiredKey = errors.New("key is required")
 
 	ErrRequiredName = errors.New("name is required")
 
+	ErrRequiredAppName = errors.New("app name is required when setting an app version")
+
 	ErrRequiredQuery = errors.New("query cannot be empty")
 
 	ErrRequiredEnabled = errors.New("enabled is required")
@@ -372,4 +536,6 @@ var (
 	ErrRequiredRawState = errors.New("RawState is required")
 
 	ErrStateVersionUploadNotSupported = errors.New("upload not supported by this version of Terraform Enterprise")
+
+	ErrRequiredSSOTeamID = errors.New("SSO team ID is required")
 )
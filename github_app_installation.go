@@ -37,9 +37,12 @@ type GHAInstallationList struct {
 
 // GHAInstallation represents a github app installation
 type GHAInstallation struct {
-	ID             *string `jsonapi:"primary,github-app-installations"`
-	InstallationID *int    `jsonapi:"attr,installation-id"`
-	Name           *string `jsonapi:"attr,name"`
+	ID               *string `jsonapi:"primary,github-app-installations"`
+	InstallationID   *int    `jsonapi:"attr,installation-id"`
+	Name             *string `jsonapi:"attr,name"`
+	IconURL          *string `jsonapi:"attr,icon-url"`
+	InstallationType *string `jsonapi:"attr,installation-type"`
+	InstallationURL  *string `jsonapi:"attr,installation-url"`
 }
 
 // GHAInstallationListOptions represents the options for listing.
@@ -51,7 +54,6 @@ type GHAInstallationListOptions struct {
 func (s *gHAInstallations) List(ctx context.Context, options *GHAInstallationListOptions) (*GHAInstallationList, error) {
 	u := "github-app/installations"
 	req, err := s.client.NewRequest("GET", u, options)
-	fmt.Println(u)
 	if err != nil {
 		return nil, err
 	}
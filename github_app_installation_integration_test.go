@@ -4,11 +4,14 @@
 package tfe
 
 import (
+	"bytes"
 	"context"
-	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
+	"encoding/json"
 	"os"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGHAInstallationList(t *testing.T) {
@@ -45,3 +48,34 @@ func TestGHAInstallationRead(t *testing.T) {
 		assert.Equal(t, *ghais.ID, gHAInstallationID)
 	})
 }
+
+func TestGHAInstallation_Unmarshal(t *testing.T) {
+	data := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "github-app-installations",
+			"id":   "ghain-1",
+			"attributes": map[string]interface{}{
+				"installation-id":   54810170,
+				"name":              "octouser",
+				"icon-url":          "https://avatars.githubusercontent.com/u/29916665?v=4",
+				"installation-type": "User",
+				"installation-url":  "https://github.com/settings/installations/54810170",
+			},
+		},
+	}
+
+	byteData, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	responseBody := bytes.NewReader(byteData)
+	ghai := &GHAInstallation{}
+	err = unmarshalResponse(responseBody, ghai)
+	require.NoError(t, err)
+
+	assert.Equal(t, "ghain-1", *ghai.ID)
+	assert.Equal(t, 54810170, *ghai.InstallationID)
+	assert.Equal(t, "octouser", *ghai.Name)
+	assert.Equal(t, "https://avatars.githubusercontent.com/u/29916665?v=4", *ghai.IconURL)
+	assert.Equal(t, "User", *ghai.InstallationType)
+	assert.Equal(t, "https://github.com/settings/installations/54810170", *ghai.InstallationURL)
+}
@@ -5,6 +5,9 @@ package tfe
 
 import (
 	"context"
+	"net/http"
+	"reflect"
+	"time"
 )
 
 // Compile-time proof of interface implementation.
@@ -19,6 +22,11 @@ type IPRanges interface {
 	// The format for `modifiedSince` can be found here:
 	// https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/If-Modified-Since
 	Read(ctx context.Context, modifiedSince string) (*IPRange, error)
+
+	// Watch polls the IP ranges every interval and calls onChange with the
+	// current ranges, then again each time they change, until the context
+	// is canceled.
+	Watch(ctx context.Context, interval time.Duration, onChange func(*IPRange)) error
 }
 
 // ipRanges implements IPRanges interface.
@@ -57,3 +65,46 @@ func (i *ipRanges) Read(ctx context.Context, modifiedSince string) (*IPRange, er
 
 	return ir, nil
 }
+
+// Watch polls the IP ranges until the context is canceled or a read fails.
+// onChange is called with the ranges from the first read and afterwards only
+// when they change. Requests send If-Modified-Since, so unchanged ranges
+// answered with a 304 are not reported.
+func (i *ipRanges) Watch(ctx context.Context, interval time.Duration, onChange func(*IPRange)) error {
+	if interval <= 0 {
+		return ErrInvalidWatchInterval
+	}
+
+	var current *IPRange
+	var modifiedSince string
+
+	return wait(ctx, &WaitOptions{PollInterval: interval}, func() (bool, error) {
+		var status int
+		var lastModified string
+		readCtx := ContextWithResponseHeaderHook(ctx, func(s int, header http.Header) {
+			status = s
+			lastModified = header.Get("Last-Modified")
+		})
+
+		requestedAt := time.Now().UTC()
+		ir, err := i.Read(readCtx, modifiedSince)
+		if err != nil {
+			return false, err
+		}
+		if status == http.StatusNotModified {
+			return false, nil
+		}
+
+		if lastModified != "" {
+			modifiedSince = lastModified
+		} else {
+			modifiedSince = requestedAt.Format(http.TimeFormat)
+		}
+
+		if current == nil || !reflect.DeepEqual(current, ir) {
+			current = ir
+			onChange(ir)
+		}
+		return false, nil
+	})
+}
@@ -5,6 +5,8 @@ package tfe
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -38,3 +40,66 @@ func TestIPRangesRead(t *testing.T) {
 		assert.Empty(t, r.VCS)
 	})
 }
+
+func TestIPRangesWatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var modifiedSince []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(204)
+		case "/api/meta/ip-ranges":
+			modifiedSince = append(modifiedSince, r.Header.Get("If-Modified-Since"))
+
+			switch len(modifiedSince) {
+			case 1:
+				w.Header().Set("Last-Modified", "Mon, 02 Jan 2023 00:00:00 GMT")
+				w.Write([]byte(`{"api":["10.0.0.0/24"],"notifications":[],"sentinel":[],"vcs":[]}`))
+			case 2:
+				w.WriteHeader(http.StatusNotModified)
+			case 3:
+				w.Header().Set("Last-Modified", "Tue, 03 Jan 2023 00:00:00 GMT")
+				w.Write([]byte(`{"api":["10.0.0.0/24","10.0.1.0/24"],"notifications":[],"sentinel":[],"vcs":[]}`))
+			default:
+				cancel()
+				w.Header().Set("Last-Modified", "Tue, 03 Jan 2023 00:00:00 GMT")
+				w.Write([]byte(`{"api":["10.0.0.0/24","10.0.1.0/24"],"notifications":[],"sentinel":[],"vcs":[]}`))
+			}
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "abcd1234",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	t.Run("with changing ranges", func(t *testing.T) {
+		var changes []*IPRange
+		err := client.Meta.IPRanges.Watch(ctx, time.Millisecond, func(r *IPRange) {
+			changes = append(changes, r)
+		})
+		assert.Equal(t, context.Canceled, err)
+
+		require.Len(t, changes, 2)
+		assert.Equal(t, []string{"10.0.0.0/24"}, changes[0].API)
+		assert.Equal(t, []string{"10.0.0.0/24", "10.0.1.0/24"}, changes[1].API)
+		assert.Equal(t, []string{
+			"",
+			"Mon, 02 Jan 2023 00:00:00 GMT",
+			"Mon, 02 Jan 2023 00:00:00 GMT",
+			"Tue, 03 Jan 2023 00:00:00 GMT",
+		}, modifiedSince)
+	})
+
+	t.Run("with an invalid interval", func(t *testing.T) {
+		err := client.Meta.IPRanges.Watch(ctx, 0, func(*IPRange) {})
+		assert.Equal(t, ErrInvalidWatchInterval, err)
+	})
+}
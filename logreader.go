@@ -4,22 +4,34 @@
 package tfe
 
 import (
+	"bufio"
+	"compress/gzip"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"math"
 	"net/http"
 	"net/url"
+	"regexp"
+	"strings"
 	"time"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
 )
 
+// reTerraformVersion matches the version banner Terraform prints at the start
+// of a plan or apply, e.g. "Terraform v1.5.7".
+var reTerraformVersion = regexp.MustCompile(`\bTerraform v(\d+\.\d+\.\d+(?:-[0-9A-Za-z.]+)?)`)
+
 // LogReader implements io.Reader for streaming logs.
 type LogReader struct {
 	client      *Client
 	ctx         context.Context
 	done        func() (bool, error)
 	logURL      *url.URL
+	backoff     WaitBackoff
 	offset      int64
 	reads       int
 	startOfText bool
@@ -27,23 +39,51 @@ type LogReader struct {
 }
 
 func (r *LogReader) Read(l []byte) (int, error) {
-	if written, err := r.read(l); !errors.Is(err, io.ErrNoProgress) {
-		return written, err
-	}
-
 	// Loop until we can any data, the context is canceled or the
 	// run is finsished. If we would return right away without any
 	// data, we could end up causing a io.ErrNoProgress error.
-	for r.reads = 1; ; r.reads++ {
-		select {
-		case <-r.ctx.Done():
-			return 0, r.ctx.Err()
-		case <-time.After(backoff(500, 2000, r.reads)):
-			if written, err := r.read(l); !errors.Is(err, io.ErrNoProgress) {
-				return written, err
-			}
+	backoff := r.backoff
+	if backoff == nil {
+		backoff = ExponentialWaitBackoff(500*time.Millisecond, 2*time.Second)
+	}
+
+	var written int
+	attempts := 0
+	err := wait(r.ctx, &WaitOptions{Backoff: backoff}, func() (bool, error) {
+		if attempts > 0 {
+			r.reads = attempts
 		}
+		attempts++
+
+		var err error
+		written, err = r.read(l)
+		if errors.Is(err, io.ErrNoProgress) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return true, nil
+	})
+	if err != nil && r.ctx.Err() != nil {
+		// Report the cancellation rather than the failed request it caused.
+		return written, r.ctx.Err()
+	}
+	return written, err
+}
+
+// do sends a log request. It is only retried, like any other API request,
+// when the client has a retry policy.
+func (r *LogReader) do(req *http.Request) (*http.Response, error) {
+	if r.client.retryPolicy == nil {
+		return r.client.http.HTTPClient.Do(req)
+	}
+
+	retryReq, err := retryablehttp.FromRequest(req)
+	if err != nil {
+		return nil, err
 	}
+	return r.client.http.Do(retryReq)
 }
 
 func (r *LogReader) read(l []byte) (int, error) {
@@ -63,7 +103,7 @@ func (r *LogReader) read(l []byte) (int, error) {
 	}
 
 	// Retrieve the next chunk.
-	resp, err := r.client.http.HTTPClient.Do(req)
+	resp, err := r.do(req)
 	if err != nil {
 		return 0, err
 	}
@@ -75,7 +115,7 @@ func (r *LogReader) read(l []byte) (int, error) {
 	}
 
 	// Read the retrieved chunk.
-	written, err := resp.Body.Read(l)
+	written, err := readLogChunk(resp, l)
 	if err != nil && !errors.Is(err, io.EOF) {
 		// Ignore io.EOF errors returned when reading from the response
 		// body as this indicates the end of the chunk and not the end
@@ -135,6 +175,32 @@ func (r *LogReader) read(l []byte) (int, error) {
 	return 0, io.ErrNoProgress
 }
 
+// readLogChunk reads a chunk of logs from resp into l. Chunks compressed by a
+// server or proxy are decompressed, unless the transport already did so.
+func readLogChunk(resp *http.Response, l []byte) (int, error) {
+	if resp.Uncompressed || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return resp.Body.Read(l)
+	}
+
+	gz, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			// The chunk is empty.
+			return 0, io.EOF
+		}
+		return 0, fmt.Errorf("invalid gzip encoded logs: %w", err)
+	}
+	defer gz.Close()
+
+	// Unlike a plain body, a single read of the decompressed chunk might not
+	// return all of the data that is available.
+	written, err := io.ReadFull(gz, l)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		err = io.EOF
+	}
+	return written, err
+}
+
 // backoff will perform exponential backoff based on the iteration and
 // limited by the provided min and max (in milliseconds) durations.
 func backoff(min, max float64, iter int) time.Duration {
@@ -144,3 +210,40 @@ func backoff(min, max float64, iter int) time.Duration {
 	}
 	return time.Duration(backoff) * time.Millisecond
 }
+
+// ParseTerraformVersionFromLog scans plan or apply log output for the
+// Terraform version banner and returns the version, without the leading "v".
+// Both human readable and JSON (terraform -json) logs are supported.
+// ErrVersionNotFound is returned when the log contains no version.
+func ParseTerraformVersionFromLog(r io.Reader) (string, error) {
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		if v := terraformVersionFromLogLine(line); v != "" {
+			return v, nil
+		}
+		if err == io.EOF {
+			return "", ErrVersionNotFound
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
+// terraformVersionFromLogLine returns the Terraform version found in a single
+// log line, or an empty string.
+func terraformVersionFromLogLine(line []byte) string {
+	var msg struct {
+		Type      string `json:"type"`
+		Terraform string `json:"terraform"`
+	}
+	if json.Unmarshal(line, &msg) == nil && msg.Type == "version" && msg.Terraform != "" {
+		return msg.Terraform
+	}
+
+	if m := reTerraformVersion.FindSubmatch(line); m != nil {
+		return string(m[1])
+	}
+	return ""
+}
@@ -4,12 +4,18 @@
 package tfe
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 // checkedWrite writes message to w and fails the test if there's an error.
@@ -262,3 +268,201 @@ func TestLogReader_withoutEndOfTextMarker(t *testing.T) {
 		t.Fatalf("expected 42 log reads, got %d reads", logReads)
 	}
 }
+
+func TestLogReader_withBackoff(t *testing.T) {
+	t.Parallel()
+
+	logReads := 0
+	ts, lr := testLogReader(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logReads++
+		if logReads == 4 {
+			checkedWrite(t, w, []byte("\x02Terraform run started - logs - Terraform run finished\x03"))
+		}
+	}))
+	defer ts.Close()
+
+	lr.done = func() (bool, error) {
+		return logReads >= 4, nil
+	}
+
+	var attempts []int
+	lr.backoff = func(attempt int) time.Duration {
+		attempts = append(attempts, attempt)
+		return time.Millisecond
+	}
+
+	logs, err := io.ReadAll(lr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "Terraform run started - logs - Terraform run finished"
+	if string(logs) != expected {
+		t.Fatalf("expected %s, got: %s", expected, string(logs))
+	}
+	if !reflect.DeepEqual(attempts, []int{0, 1}) {
+		t.Fatalf("expected delays after attempts [0 1], got %v", attempts)
+	}
+}
+
+func TestLogReader_gzipped(t *testing.T) {
+	t.Parallel()
+
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	checkedWrite(t, gz, []byte("\x02Terraform run started - logs - Terraform run finished\x03"))
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	logReads := 0
+	ts, lr := testLogReader(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logReads++
+		w.Header().Set("Content-Encoding", "gzip")
+		if logReads == 2 {
+			checkedWrite(t, w, compressed.Bytes())
+		}
+	}))
+	defer ts.Close()
+
+	// Behave like a client that didn't ask for compressed responses, so the
+	// transport leaves decompressing to the log reader.
+	ts.Client().Transport.(*http.Transport).DisableCompression = true
+
+	lr.done = func() (bool, error) {
+		return true, nil
+	}
+	lr.backoff = func(int) time.Duration {
+		return time.Millisecond
+	}
+
+	logs, err := io.ReadAll(lr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "Terraform run started - logs - Terraform run finished"
+	if string(logs) != expected {
+		t.Fatalf("expected %s, got: %s", expected, string(logs))
+	}
+}
+
+func TestLogReader_canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logReads := 0
+	ts, lr := testLogReader(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logReads++
+		if logReads == 3 {
+			cancel()
+		}
+	}))
+	defer ts.Close()
+
+	lr.ctx = ctx
+	lr.done = func() (bool, error) {
+		return false, nil
+	}
+	lr.backoff = func(int) time.Duration {
+		return time.Millisecond
+	}
+
+	_, err := io.ReadAll(lr)
+	if err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got: %v", err)
+	}
+}
+
+func TestLogReader_rateLimited(t *testing.T) {
+	t.Parallel()
+
+	var logReads int
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		logReads++
+		if logReads == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		checkedWrite(t, w, []byte("\x02Terraform run\x03"))
+	})
+
+	t.Run("without a retry policy", func(t *testing.T) {
+		logReads = 0
+		ts, lr := testLogReader(t, handler)
+		defer ts.Close()
+
+		_, err := lr.read(make([]byte, 64))
+		if err == nil {
+			t.Fatal("expected an error, got nil")
+		}
+		if logReads != 1 {
+			t.Fatalf("expected 1 log read, got: %d", logReads)
+		}
+	})
+
+	t.Run("with a retry policy", func(t *testing.T) {
+		logReads = 0
+		ts, lr := testLogReader(t, handler)
+		defer ts.Close()
+		lr.client.retryPolicy = &RetryPolicy{}
+
+		_, err := lr.read(make([]byte, 64))
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if logReads != 2 {
+			t.Fatalf("expected 2 log reads, got: %d", logReads)
+		}
+	})
+}
+
+func TestParseTerraformVersionFromLog(t *testing.T) {
+	for name, tc := range map[string]struct {
+		log     string
+		version string
+		err     error
+	}{
+		"with a human readable log": {
+			log:     "\x02Terraform v1.5.7\non linux_amd64\nInitializing plugins and modules...\n",
+			version: "1.5.7",
+		},
+		"with a pre-release version": {
+			log:     "Terraform v1.6.0-beta2\non linux_amd64\n",
+			version: "1.6.0-beta2",
+		},
+		"with a JSON log": {
+			log:     `{"@level":"info","@message":"Terraform 1.4.6","terraform":"1.4.6","type":"version","ui":"1.1"}` + "\n",
+			version: "1.4.6",
+		},
+		"without a trailing newline": {
+			log:     "Executing pre-plan hooks...\nTerraform v0.15.5",
+			version: "0.15.5",
+		},
+		"without a version banner": {
+			log: "Initializing plugins and modules...\nNo changes. Infrastructure is up-to-date.\n",
+			err: ErrVersionNotFound,
+		},
+		"with an empty log": {
+			err: ErrVersionNotFound,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			version, err := ParseTerraformVersionFromLog(strings.NewReader(tc.log))
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+			if version != tc.version {
+				t.Fatalf("expected version %q, got %q", tc.version, version)
+			}
+		})
+	}
+}
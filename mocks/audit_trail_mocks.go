@@ -6,7 +6,9 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	tfe "github.com/hashicorp/go-tfe"
@@ -35,6 +37,21 @@ func (m *MockAuditTrails) EXPECT() *MockAuditTrailsMockRecorder {
 	return m.recorder
 }
 
+// Export mocks base method.
+func (m *MockAuditTrails) Export(ctx context.Context, w io.Writer, options tfe.AuditTrailExportOptions) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, w, options)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockAuditTrailsMockRecorder) Export(ctx, w, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockAuditTrails)(nil).Export), ctx, w, options)
+}
+
 // List mocks base method.
 func (m *MockAuditTrails) List(ctx context.Context, options *tfe.AuditTrailListOptions) (*tfe.AuditTrailList, error) {
 	m.ctrl.T.Helper()
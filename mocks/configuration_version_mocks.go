@@ -209,3 +209,23 @@ func (mr *MockConfigurationVersionsMockRecorder) UploadTarGzip(ctx, url, archive
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadTarGzip", reflect.TypeOf((*MockConfigurationVersions)(nil).UploadTarGzip), ctx, url, archive)
 }
+
+// WaitForStatus mocks base method.
+func (m *MockConfigurationVersions) WaitForStatus(ctx context.Context, cvID string, options *tfe.WaitOptions, statuses ...tfe.ConfigurationStatus) (*tfe.ConfigurationVersion, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, cvID, options}
+	for _, a := range statuses {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitForStatus", varargs...)
+	ret0, _ := ret[0].(*tfe.ConfigurationVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForStatus indicates an expected call of WaitForStatus.
+func (mr *MockConfigurationVersionsMockRecorder) WaitForStatus(ctx, cvID, options interface{}, statuses ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, cvID, options}, statuses...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForStatus", reflect.TypeOf((*MockConfigurationVersions)(nil).WaitForStatus), varargs...)
+}
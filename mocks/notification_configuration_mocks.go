@@ -94,6 +94,21 @@ func (mr *MockNotificationConfigurationsMockRecorder) Read(ctx, notificationConf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockNotificationConfigurations)(nil).Read), ctx, notificationConfigurationID)
 }
 
+// SetEnabled mocks base method.
+func (m *MockNotificationConfigurations) SetEnabled(ctx context.Context, notificationConfigurationIDs []string, enabled bool) ([]*tfe.NotificationConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEnabled", ctx, notificationConfigurationIDs, enabled)
+	ret0, _ := ret[0].([]*tfe.NotificationConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEnabled indicates an expected call of SetEnabled.
+func (mr *MockNotificationConfigurationsMockRecorder) SetEnabled(ctx, notificationConfigurationIDs, enabled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEnabled", reflect.TypeOf((*MockNotificationConfigurations)(nil).SetEnabled), ctx, notificationConfigurationIDs, enabled)
+}
+
 // Update mocks base method.
 func (m *MockNotificationConfigurations) Update(ctx context.Context, notificationConfigurationID string, options tfe.NotificationConfigurationUpdateOptions) (*tfe.NotificationConfiguration, error) {
 	m.ctrl.T.Helper()
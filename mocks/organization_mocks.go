@@ -93,6 +93,21 @@ func (mr *MockOrganizationsMockRecorder) List(ctx, options interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOrganizations)(nil).List), ctx, options)
 }
 
+// ListAvailableTerraformVersions mocks base method.
+func (m *MockOrganizations) ListAvailableTerraformVersions(ctx context.Context, organization string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAvailableTerraformVersions", ctx, organization)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAvailableTerraformVersions indicates an expected call of ListAvailableTerraformVersions.
+func (mr *MockOrganizationsMockRecorder) ListAvailableTerraformVersions(ctx, organization interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAvailableTerraformVersions", reflect.TypeOf((*MockOrganizations)(nil).ListAvailableTerraformVersions), ctx, organization)
+}
+
 // Read mocks base method.
 func (m *MockOrganizations) Read(ctx context.Context, organization string) (*tfe.Organization, error) {
 	m.ctrl.T.Helper()
@@ -138,6 +153,21 @@ func (mr *MockOrganizationsMockRecorder) ReadDataRetentionPolicy(ctx, organizati
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDataRetentionPolicy", reflect.TypeOf((*MockOrganizations)(nil).ReadDataRetentionPolicy), ctx, organization)
 }
 
+// ReadDefaultProject mocks base method.
+func (m *MockOrganizations) ReadDefaultProject(ctx context.Context, organization string) (*tfe.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadDefaultProject", ctx, organization)
+	ret0, _ := ret[0].(*tfe.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadDefaultProject indicates an expected call of ReadDefaultProject.
+func (mr *MockOrganizationsMockRecorder) ReadDefaultProject(ctx, organization interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDefaultProject", reflect.TypeOf((*MockOrganizations)(nil).ReadDefaultProject), ctx, organization)
+}
+
 // ReadEntitlements mocks base method.
 func (m *MockOrganizations) ReadEntitlements(ctx context.Context, organization string) (*tfe.Entitlements, error) {
 	m.ctrl.T.Helper()
@@ -168,6 +198,36 @@ func (mr *MockOrganizationsMockRecorder) ReadRunQueue(ctx, organization, options
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRunQueue", reflect.TypeOf((*MockOrganizations)(nil).ReadRunQueue), ctx, organization, options)
 }
 
+// ReadRunTasksGlobalList mocks base method.
+func (m *MockOrganizations) ReadRunTasksGlobalList(ctx context.Context, organization string) ([]*tfe.RunTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRunTasksGlobalList", ctx, organization)
+	ret0, _ := ret[0].([]*tfe.RunTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadRunTasksGlobalList indicates an expected call of ReadRunTasksGlobalList.
+func (mr *MockOrganizationsMockRecorder) ReadRunTasksGlobalList(ctx, organization interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRunTasksGlobalList", reflect.TypeOf((*MockOrganizations)(nil).ReadRunTasksGlobalList), ctx, organization)
+}
+
+// ReadTagBindings mocks base method.
+func (m *MockOrganizations) ReadTagBindings(ctx context.Context, organization string) (*tfe.OrganizationTagBindings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTagBindings", ctx, organization)
+	ret0, _ := ret[0].(*tfe.OrganizationTagBindings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTagBindings indicates an expected call of ReadTagBindings.
+func (mr *MockOrganizationsMockRecorder) ReadTagBindings(ctx, organization interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTagBindings", reflect.TypeOf((*MockOrganizations)(nil).ReadTagBindings), ctx, organization)
+}
+
 // ReadWithOptions mocks base method.
 func (m *MockOrganizations) ReadWithOptions(ctx context.Context, organization string, options tfe.OrganizationReadOptions) (*tfe.Organization, error) {
 	m.ctrl.T.Helper()
@@ -212,3 +272,18 @@ func (mr *MockOrganizationsMockRecorder) Update(ctx, organization, options inter
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrganizations)(nil).Update), ctx, organization, options)
 }
+
+// UpdateRunTaskStageDefaults mocks base method.
+func (m *MockOrganizations) UpdateRunTaskStageDefaults(ctx context.Context, organization string, options tfe.RunTaskStageDefaultsUpdateOptions) (*tfe.RunTaskStageDefaults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRunTaskStageDefaults", ctx, organization, options)
+	ret0, _ := ret[0].(*tfe.RunTaskStageDefaults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRunTaskStageDefaults indicates an expected call of UpdateRunTaskStageDefaults.
+func (mr *MockOrganizationsMockRecorder) UpdateRunTaskStageDefaults(ctx, organization, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRunTaskStageDefaults", reflect.TypeOf((*MockOrganizations)(nil).UpdateRunTaskStageDefaults), ctx, organization, options)
+}
@@ -93,3 +93,18 @@ func (mr *MockPlanExportsMockRecorder) Read(ctx, planExportID interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockPlanExports)(nil).Read), ctx, planExportID)
 }
+
+// ReadDownloadURL mocks base method.
+func (m *MockPlanExports) ReadDownloadURL(ctx context.Context, planExportID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadDownloadURL", ctx, planExportID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadDownloadURL indicates an expected call of ReadDownloadURL.
+func (mr *MockPlanExportsMockRecorder) ReadDownloadURL(ctx, planExportID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDownloadURL", reflect.TypeOf((*MockPlanExports)(nil).ReadDownloadURL), ctx, planExportID)
+}
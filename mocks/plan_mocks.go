@@ -36,6 +36,35 @@ func (m *MockPlans) EXPECT() *MockPlansMockRecorder {
 	return m.recorder
 }
 
+// Cancel mocks base method.
+func (m *MockPlans) Cancel(ctx context.Context, planID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, planID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockPlansMockRecorder) Cancel(ctx, planID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockPlans)(nil).Cancel), ctx, planID)
+}
+
+// List mocks base method.
+func (m *MockPlans) List(ctx context.Context, options tfe.PlanListOptions) (*tfe.PlanList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, options)
+	ret0, _ := ret[0].(*tfe.PlanList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPlansMockRecorder) List(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPlans)(nil).List), ctx, options)
+}
+
 // Logs mocks base method.
 func (m *MockPlans) Logs(ctx context.Context, planID string) (io.Reader, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +80,21 @@ func (mr *MockPlansMockRecorder) Logs(ctx, planID interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logs", reflect.TypeOf((*MockPlans)(nil).Logs), ctx, planID)
 }
 
+// LogsWithOptions mocks base method.
+func (m *MockPlans) LogsWithOptions(ctx context.Context, planID string, options tfe.PlanLogOptions) (io.Reader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogsWithOptions", ctx, planID, options)
+	ret0, _ := ret[0].(io.Reader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogsWithOptions indicates an expected call of LogsWithOptions.
+func (mr *MockPlansMockRecorder) LogsWithOptions(ctx, planID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogsWithOptions", reflect.TypeOf((*MockPlans)(nil).LogsWithOptions), ctx, planID, options)
+}
+
 // Read mocks base method.
 func (m *MockPlans) Read(ctx context.Context, planID string) (*tfe.Plan, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +110,66 @@ func (mr *MockPlansMockRecorder) Read(ctx, planID interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockPlans)(nil).Read), ctx, planID)
 }
 
+// ReadCostEstimate mocks base method.
+func (m *MockPlans) ReadCostEstimate(ctx context.Context, planID string) (*tfe.CostEstimate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadCostEstimate", ctx, planID)
+	ret0, _ := ret[0].(*tfe.CostEstimate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadCostEstimate indicates an expected call of ReadCostEstimate.
+func (mr *MockPlansMockRecorder) ReadCostEstimate(ctx, planID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadCostEstimate", reflect.TypeOf((*MockPlans)(nil).ReadCostEstimate), ctx, planID)
+}
+
+// ReadDiagnostics mocks base method.
+func (m *MockPlans) ReadDiagnostics(ctx context.Context, planID string) ([]tfe.Diagnostic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadDiagnostics", ctx, planID)
+	ret0, _ := ret[0].([]tfe.Diagnostic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadDiagnostics indicates an expected call of ReadDiagnostics.
+func (mr *MockPlansMockRecorder) ReadDiagnostics(ctx, planID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDiagnostics", reflect.TypeOf((*MockPlans)(nil).ReadDiagnostics), ctx, planID)
+}
+
+// ReadExport mocks base method.
+func (m *MockPlans) ReadExport(ctx context.Context, planID string, w io.Writer, options tfe.PlanReadExportOptions) (*tfe.PlanExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadExport", ctx, planID, w, options)
+	ret0, _ := ret[0].(*tfe.PlanExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadExport indicates an expected call of ReadExport.
+func (mr *MockPlansMockRecorder) ReadExport(ctx, planID, w, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadExport", reflect.TypeOf((*MockPlans)(nil).ReadExport), ctx, planID, w, options)
+}
+
+// ReadForRun mocks base method.
+func (m *MockPlans) ReadForRun(ctx context.Context, runID string) (*tfe.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadForRun", ctx, runID)
+	ret0, _ := ret[0].(*tfe.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadForRun indicates an expected call of ReadForRun.
+func (mr *MockPlansMockRecorder) ReadForRun(ctx, runID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadForRun", reflect.TypeOf((*MockPlans)(nil).ReadForRun), ctx, runID)
+}
+
 // ReadJSONOutput mocks base method.
 func (m *MockPlans) ReadJSONOutput(ctx context.Context, planID string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -80,3 +184,97 @@ func (mr *MockPlansMockRecorder) ReadJSONOutput(ctx, planID interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadJSONOutput", reflect.TypeOf((*MockPlans)(nil).ReadJSONOutput), ctx, planID)
 }
+
+// ReadJSONOutputStruct mocks base method.
+func (m *MockPlans) ReadJSONOutputStruct(ctx context.Context, planID string) (*tfe.JSONOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadJSONOutputStruct", ctx, planID)
+	ret0, _ := ret[0].(*tfe.JSONOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadJSONOutputStruct indicates an expected call of ReadJSONOutputStruct.
+func (mr *MockPlansMockRecorder) ReadJSONOutputStruct(ctx, planID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadJSONOutputStruct", reflect.TypeOf((*MockPlans)(nil).ReadJSONOutputStruct), ctx, planID)
+}
+
+// ReadOutputChanges mocks base method.
+func (m *MockPlans) ReadOutputChanges(ctx context.Context, planID string) (map[string]tfe.Change, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadOutputChanges", ctx, planID)
+	ret0, _ := ret[0].(map[string]tfe.Change)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadOutputChanges indicates an expected call of ReadOutputChanges.
+func (mr *MockPlansMockRecorder) ReadOutputChanges(ctx, planID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadOutputChanges", reflect.TypeOf((*MockPlans)(nil).ReadOutputChanges), ctx, planID)
+}
+
+// ReadResourceChanges mocks base method.
+func (m *MockPlans) ReadResourceChanges(ctx context.Context, planID string) (*tfe.PlanResourceChanges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadResourceChanges", ctx, planID)
+	ret0, _ := ret[0].(*tfe.PlanResourceChanges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadResourceChanges indicates an expected call of ReadResourceChanges.
+func (mr *MockPlansMockRecorder) ReadResourceChanges(ctx, planID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadResourceChanges", reflect.TypeOf((*MockPlans)(nil).ReadResourceChanges), ctx, planID)
+}
+
+// ReadWithOptions mocks base method.
+func (m *MockPlans) ReadWithOptions(ctx context.Context, planID string, options tfe.PlanReadOptions) (*tfe.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWithOptions", ctx, planID, options)
+	ret0, _ := ret[0].(*tfe.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWithOptions indicates an expected call of ReadWithOptions.
+func (mr *MockPlansMockRecorder) ReadWithOptions(ctx, planID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWithOptions", reflect.TypeOf((*MockPlans)(nil).ReadWithOptions), ctx, planID, options)
+}
+
+// StreamResourceChanges mocks base method.
+func (m *MockPlans) StreamResourceChanges(ctx context.Context, planID string, fn func(tfe.ResourceChange) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamResourceChanges", ctx, planID, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamResourceChanges indicates an expected call of StreamResourceChanges.
+func (mr *MockPlansMockRecorder) StreamResourceChanges(ctx, planID, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamResourceChanges", reflect.TypeOf((*MockPlans)(nil).StreamResourceChanges), ctx, planID, fn)
+}
+
+// WaitForStatus mocks base method.
+func (m *MockPlans) WaitForStatus(ctx context.Context, planID string, options *tfe.WaitOptions, statuses ...tfe.PlanStatus) (*tfe.Plan, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, planID, options}
+	for _, a := range statuses {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitForStatus", varargs...)
+	ret0, _ := ret[0].(*tfe.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForStatus indicates an expected call of WaitForStatus.
+func (mr *MockPlansMockRecorder) WaitForStatus(ctx, planID, options interface{}, statuses ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, planID, options}, statuses...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForStatus", reflect.TypeOf((*MockPlans)(nil).WaitForStatus), varargs...)
+}
@@ -79,6 +79,36 @@ func (mr *MockProjectsMockRecorder) List(ctx, organization, options interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjects)(nil).List), ctx, organization, options)
 }
 
+// ListEffectiveTagBindings mocks base method.
+func (m *MockProjects) ListEffectiveTagBindings(ctx context.Context, projectID string) ([]*tfe.EffectiveTagBinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEffectiveTagBindings", ctx, projectID)
+	ret0, _ := ret[0].([]*tfe.EffectiveTagBinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEffectiveTagBindings indicates an expected call of ListEffectiveTagBindings.
+func (mr *MockProjectsMockRecorder) ListEffectiveTagBindings(ctx, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEffectiveTagBindings", reflect.TypeOf((*MockProjects)(nil).ListEffectiveTagBindings), ctx, projectID)
+}
+
+// ListTagBindings mocks base method.
+func (m *MockProjects) ListTagBindings(ctx context.Context, projectID string) ([]*tfe.TagBinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagBindings", ctx, projectID)
+	ret0, _ := ret[0].([]*tfe.TagBinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagBindings indicates an expected call of ListTagBindings.
+func (mr *MockProjectsMockRecorder) ListTagBindings(ctx, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagBindings", reflect.TypeOf((*MockProjects)(nil).ListTagBindings), ctx, projectID)
+}
+
 // Read mocks base method.
 func (m *MockProjects) Read(ctx context.Context, projectID string) (*tfe.Project, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +124,36 @@ func (mr *MockProjectsMockRecorder) Read(ctx, projectID interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockProjects)(nil).Read), ctx, projectID)
 }
 
+// ReadHealthSummary mocks base method.
+func (m *MockProjects) ReadHealthSummary(ctx context.Context, projectID string) (*tfe.ProjectHealthSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadHealthSummary", ctx, projectID)
+	ret0, _ := ret[0].(*tfe.ProjectHealthSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadHealthSummary indicates an expected call of ReadHealthSummary.
+func (mr *MockProjectsMockRecorder) ReadHealthSummary(ctx, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadHealthSummary", reflect.TypeOf((*MockProjects)(nil).ReadHealthSummary), ctx, projectID)
+}
+
+// ReadWithOptions mocks base method.
+func (m *MockProjects) ReadWithOptions(ctx context.Context, projectID string, options *tfe.ProjectReadOptions) (*tfe.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWithOptions", ctx, projectID, options)
+	ret0, _ := ret[0].(*tfe.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWithOptions indicates an expected call of ReadWithOptions.
+func (mr *MockProjectsMockRecorder) ReadWithOptions(ctx, projectID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWithOptions", reflect.TypeOf((*MockProjects)(nil).ReadWithOptions), ctx, projectID, options)
+}
+
 // Update mocks base method.
 func (m *MockProjects) Update(ctx context.Context, projectID string, options tfe.ProjectUpdateOptions) (*tfe.Project, error) {
 	m.ctrl.T.Helper()
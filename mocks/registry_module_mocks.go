@@ -153,6 +153,21 @@ func (mr *MockRegistryModulesMockRecorder) ListCommits(ctx, moduleID interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommits", reflect.TypeOf((*MockRegistryModules)(nil).ListCommits), ctx, moduleID)
 }
 
+// ListConsumingWorkspaces mocks base method.
+func (m *MockRegistryModules) ListConsumingWorkspaces(ctx context.Context, moduleID tfe.RegistryModuleID) ([]*tfe.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConsumingWorkspaces", ctx, moduleID)
+	ret0, _ := ret[0].([]*tfe.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConsumingWorkspaces indicates an expected call of ListConsumingWorkspaces.
+func (mr *MockRegistryModulesMockRecorder) ListConsumingWorkspaces(ctx, moduleID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConsumingWorkspaces", reflect.TypeOf((*MockRegistryModules)(nil).ListConsumingWorkspaces), ctx, moduleID)
+}
+
 // Read mocks base method.
 func (m *MockRegistryModules) Read(ctx context.Context, moduleID tfe.RegistryModuleID) (*tfe.RegistryModule, error) {
 	m.ctrl.T.Helper()
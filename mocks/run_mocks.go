@@ -78,6 +78,36 @@ func (mr *MockRunsMockRecorder) Create(ctx, options interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRuns)(nil).Create), ctx, options)
 }
 
+// CreateDestroy mocks base method.
+func (m *MockRuns) CreateDestroy(ctx context.Context, options tfe.RunCreateDestroyOptions) (*tfe.Run, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDestroy", ctx, options)
+	ret0, _ := ret[0].(*tfe.Run)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDestroy indicates an expected call of CreateDestroy.
+func (mr *MockRunsMockRecorder) CreateDestroy(ctx, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDestroy", reflect.TypeOf((*MockRuns)(nil).CreateDestroy), ctx, options)
+}
+
+// CreateSpeculativeFromVCS mocks base method.
+func (m *MockRuns) CreateSpeculativeFromVCS(ctx context.Context, workspaceID string, options tfe.RunCreateSpeculativeFromVCSOptions) (*tfe.Run, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSpeculativeFromVCS", ctx, workspaceID, options)
+	ret0, _ := ret[0].(*tfe.Run)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSpeculativeFromVCS indicates an expected call of CreateSpeculativeFromVCS.
+func (mr *MockRunsMockRecorder) CreateSpeculativeFromVCS(ctx, workspaceID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSpeculativeFromVCS", reflect.TypeOf((*MockRuns)(nil).CreateSpeculativeFromVCS), ctx, workspaceID, options)
+}
+
 // Discard mocks base method.
 func (m *MockRuns) Discard(ctx context.Context, runID string, options tfe.RunDiscardOptions) error {
 	m.ctrl.T.Helper()
@@ -92,6 +122,21 @@ func (mr *MockRunsMockRecorder) Discard(ctx, runID, options interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Discard", reflect.TypeOf((*MockRuns)(nil).Discard), ctx, runID, options)
 }
 
+// ExportSentinelMockData mocks base method.
+func (m *MockRuns) ExportSentinelMockData(ctx context.Context, runID string, options *tfe.WaitOptions) (*tfe.PlanExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportSentinelMockData", ctx, runID, options)
+	ret0, _ := ret[0].(*tfe.PlanExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportSentinelMockData indicates an expected call of ExportSentinelMockData.
+func (mr *MockRunsMockRecorder) ExportSentinelMockData(ctx, runID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSentinelMockData", reflect.TypeOf((*MockRuns)(nil).ExportSentinelMockData), ctx, runID, options)
+}
+
 // ForceCancel mocks base method.
 func (m *MockRuns) ForceCancel(ctx context.Context, runID string, options tfe.RunForceCancelOptions) error {
 	m.ctrl.T.Helper()
@@ -164,3 +209,33 @@ func (mr *MockRunsMockRecorder) ReadWithOptions(ctx, runID, options interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWithOptions", reflect.TypeOf((*MockRuns)(nil).ReadWithOptions), ctx, runID, options)
 }
+
+// Retry mocks base method.
+func (m *MockRuns) Retry(ctx context.Context, runID string, options tfe.RunRetryOptions) (*tfe.Run, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retry", ctx, runID, options)
+	ret0, _ := ret[0].(*tfe.Run)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Retry indicates an expected call of Retry.
+func (mr *MockRunsMockRecorder) Retry(ctx, runID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retry", reflect.TypeOf((*MockRuns)(nil).Retry), ctx, runID, options)
+}
+
+// StreamLogs mocks base method.
+func (m *MockRuns) StreamLogs(ctx context.Context, runID string) (<-chan tfe.LogLineOrError, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamLogs", ctx, runID)
+	ret0, _ := ret[0].(<-chan tfe.LogLineOrError)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamLogs indicates an expected call of StreamLogs.
+func (mr *MockRunsMockRecorder) StreamLogs(ctx, runID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamLogs", reflect.TypeOf((*MockRuns)(nil).StreamLogs), ctx, runID)
+}
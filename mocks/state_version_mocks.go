@@ -95,6 +95,21 @@ func (mr *MockStateVersionsMockRecorder) ListOutputs(ctx, svID, options interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutputs", reflect.TypeOf((*MockStateVersions)(nil).ListOutputs), ctx, svID, options)
 }
 
+// ListResources mocks base method.
+func (m *MockStateVersions) ListResources(ctx context.Context, svID string, options *tfe.StateVersionResourcesListOptions) (*tfe.StateVersionResourceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResources", ctx, svID, options)
+	ret0, _ := ret[0].(*tfe.StateVersionResourceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResources indicates an expected call of ListResources.
+func (mr *MockStateVersionsMockRecorder) ListResources(ctx, svID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResources", reflect.TypeOf((*MockStateVersions)(nil).ListResources), ctx, svID, options)
+}
+
 // PermanentlyDeleteBackingData mocks base method.
 func (m *MockStateVersions) PermanentlyDeleteBackingData(ctx context.Context, svID string) error {
 	m.ctrl.T.Helper()
@@ -154,6 +169,21 @@ func (mr *MockStateVersionsMockRecorder) ReadCurrentWithOptions(ctx, workspaceID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadCurrentWithOptions", reflect.TypeOf((*MockStateVersions)(nil).ReadCurrentWithOptions), ctx, workspaceID, options)
 }
 
+// ReadDownloadURL mocks base method.
+func (m *MockStateVersions) ReadDownloadURL(ctx context.Context, svID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadDownloadURL", ctx, svID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadDownloadURL indicates an expected call of ReadDownloadURL.
+func (mr *MockStateVersionsMockRecorder) ReadDownloadURL(ctx, svID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDownloadURL", reflect.TypeOf((*MockStateVersions)(nil).ReadDownloadURL), ctx, svID)
+}
+
 // ReadWithOptions mocks base method.
 func (m *MockStateVersions) ReadWithOptions(ctx context.Context, svID string, options *tfe.StateVersionReadOptions) (*tfe.StateVersion, error) {
 	m.ctrl.T.Helper()
@@ -49,3 +49,18 @@ func (mr *MockTaskResultsMockRecorder) Read(ctx, taskResultID interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockTaskResults)(nil).Read), ctx, taskResultID)
 }
+
+// ReadURL mocks base method.
+func (m *MockTaskResults) ReadURL(ctx context.Context, taskResultID string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadURL", ctx, taskResultID)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadURL indicates an expected call of ReadURL.
+func (mr *MockTaskResultsMockRecorder) ReadURL(ctx, taskResultID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadURL", reflect.TypeOf((*MockTaskResults)(nil).ReadURL), ctx, taskResultID)
+}
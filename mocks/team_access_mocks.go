@@ -50,6 +50,21 @@ func (mr *MockTeamAccessesMockRecorder) Add(ctx, options interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockTeamAccesses)(nil).Add), ctx, options)
 }
 
+// Apply mocks base method.
+func (m *MockTeamAccesses) Apply(ctx context.Context, workspaceID string, desired []tfe.TeamAccessSpec) ([]*tfe.TeamAccessOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Apply", ctx, workspaceID, desired)
+	ret0, _ := ret[0].([]*tfe.TeamAccessOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Apply indicates an expected call of Apply.
+func (mr *MockTeamAccessesMockRecorder) Apply(ctx, workspaceID, desired interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockTeamAccesses)(nil).Apply), ctx, workspaceID, desired)
+}
+
 // List mocks base method.
 func (m *MockTeamAccesses) List(ctx context.Context, options *tfe.TeamAccessListOptions) (*tfe.TeamAccessList, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +95,21 @@ func (mr *MockTeamAccessesMockRecorder) Read(ctx, teamAccessID interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockTeamAccesses)(nil).Read), ctx, teamAccessID)
 }
 
+// ReadEffective mocks base method.
+func (m *MockTeamAccesses) ReadEffective(ctx context.Context, teamID, workspaceID string) (*tfe.TeamWorkspaceEffectiveAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEffective", ctx, teamID, workspaceID)
+	ret0, _ := ret[0].(*tfe.TeamWorkspaceEffectiveAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEffective indicates an expected call of ReadEffective.
+func (mr *MockTeamAccessesMockRecorder) ReadEffective(ctx, teamID, workspaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEffective", reflect.TypeOf((*MockTeamAccesses)(nil).ReadEffective), ctx, teamID, workspaceID)
+}
+
 // Remove mocks base method.
 func (m *MockTeamAccesses) Remove(ctx context.Context, teamAccessID string) error {
 	m.ctrl.T.Helper()
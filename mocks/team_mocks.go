@@ -35,6 +35,21 @@ func (m *MockTeams) EXPECT() *MockTeamsMockRecorder {
 	return m.recorder
 }
 
+// ClearSSOTeamID mocks base method.
+func (m *MockTeams) ClearSSOTeamID(ctx context.Context, teamID string) (*tfe.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearSSOTeamID", ctx, teamID)
+	ret0, _ := ret[0].(*tfe.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearSSOTeamID indicates an expected call of ClearSSOTeamID.
+func (mr *MockTeamsMockRecorder) ClearSSOTeamID(ctx, teamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearSSOTeamID", reflect.TypeOf((*MockTeams)(nil).ClearSSOTeamID), ctx, teamID)
+}
+
 // Create mocks base method.
 func (m *MockTeams) Create(ctx context.Context, organization string, options tfe.TeamCreateOptions) (*tfe.Team, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +109,21 @@ func (mr *MockTeamsMockRecorder) Read(ctx, teamID interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockTeams)(nil).Read), ctx, teamID)
 }
 
+// ReadWithOptions mocks base method.
+func (m *MockTeams) ReadWithOptions(ctx context.Context, teamID string, options *tfe.TeamReadOptions) (*tfe.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWithOptions", ctx, teamID, options)
+	ret0, _ := ret[0].(*tfe.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWithOptions indicates an expected call of ReadWithOptions.
+func (mr *MockTeamsMockRecorder) ReadWithOptions(ctx, teamID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWithOptions", reflect.TypeOf((*MockTeams)(nil).ReadWithOptions), ctx, teamID, options)
+}
+
 // Update mocks base method.
 func (m *MockTeams) Update(ctx context.Context, teamID string, options tfe.TeamUpdateOptions) (*tfe.Team, error) {
 	m.ctrl.T.Helper()
@@ -108,3 +138,18 @@ func (mr *MockTeamsMockRecorder) Update(ctx, teamID, options interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTeams)(nil).Update), ctx, teamID, options)
 }
+
+// UpdateSSOTeamID mocks base method.
+func (m *MockTeams) UpdateSSOTeamID(ctx context.Context, teamID, ssoTeamID string) (*tfe.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSSOTeamID", ctx, teamID, ssoTeamID)
+	ret0, _ := ret[0].(*tfe.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSSOTeamID indicates an expected call of UpdateSSOTeamID.
+func (mr *MockTeamsMockRecorder) UpdateSSOTeamID(ctx, teamID, ssoTeamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSSOTeamID", reflect.TypeOf((*MockTeams)(nil).UpdateSSOTeamID), ctx, teamID, ssoTeamID)
+}
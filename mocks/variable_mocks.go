@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -35,6 +36,21 @@ func (m *MockVariables) EXPECT() *MockVariablesMockRecorder {
 	return m.recorder
 }
 
+// ApplyTfvars mocks base method.
+func (m *MockVariables) ApplyTfvars(ctx context.Context, workspaceID string, r io.Reader, category tfe.CategoryType, options tfe.ApplyTfvarsOptions) ([]*tfe.Variable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyTfvars", ctx, workspaceID, r, category, options)
+	ret0, _ := ret[0].([]*tfe.Variable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyTfvars indicates an expected call of ApplyTfvars.
+func (mr *MockVariablesMockRecorder) ApplyTfvars(ctx, workspaceID, r, category, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyTfvars", reflect.TypeOf((*MockVariables)(nil).ApplyTfvars), ctx, workspaceID, r, category, options)
+}
+
 // Create mocks base method.
 func (m *MockVariables) Create(ctx context.Context, workspaceID string, options tfe.VariableCreateOptions) (*tfe.Variable, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +80,20 @@ func (mr *MockVariablesMockRecorder) Delete(ctx, workspaceID, variableID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVariables)(nil).Delete), ctx, workspaceID, variableID)
 }
 
+// DeleteByKey mocks base method.
+func (m *MockVariables) DeleteByKey(ctx context.Context, workspaceID, key string, category tfe.CategoryType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByKey", ctx, workspaceID, key, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByKey indicates an expected call of DeleteByKey.
+func (mr *MockVariablesMockRecorder) DeleteByKey(ctx, workspaceID, key, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByKey", reflect.TypeOf((*MockVariables)(nil).DeleteByKey), ctx, workspaceID, key, category)
+}
+
 // List mocks base method.
 func (m *MockVariables) List(ctx context.Context, workspaceID string, options *tfe.VariableListOptions) (*tfe.VariableList, error) {
 	m.ctrl.T.Helper()
@@ -35,6 +35,21 @@ func (m *MockVariableSetVariables) EXPECT() *MockVariableSetVariablesMockRecorde
 	return m.recorder
 }
 
+// BatchUpsert mocks base method.
+func (m *MockVariableSetVariables) BatchUpsert(ctx context.Context, variableSetID string, options []*tfe.VariableSetVariableCreateOptions) ([]*tfe.VariableSetVariable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpsert", ctx, variableSetID, options)
+	ret0, _ := ret[0].([]*tfe.VariableSetVariable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchUpsert indicates an expected call of BatchUpsert.
+func (mr *MockVariableSetVariablesMockRecorder) BatchUpsert(ctx, variableSetID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpsert", reflect.TypeOf((*MockVariableSetVariables)(nil).BatchUpsert), ctx, variableSetID, options)
+}
+
 // Create mocks base method.
 func (m *MockVariableSetVariables) Create(ctx context.Context, variableSetID string, options *tfe.VariableSetVariableCreateOptions) (*tfe.VariableSetVariable, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +64,21 @@ func (mr *MockWorkspacesMockRecorder) AddTags(ctx, workspaceID, options interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTags", reflect.TypeOf((*MockWorkspaces)(nil).AddTags), ctx, workspaceID, options)
 }
 
+// AddTagsByFilter mocks base method.
+func (m *MockWorkspaces) AddTagsByFilter(ctx context.Context, organization string, filter tfe.WorkspaceListOptions, tags []string, options *tfe.WorkspaceTagsByFilterOptions) ([]*tfe.WorkspaceTagsByFilterResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTagsByFilter", ctx, organization, filter, tags, options)
+	ret0, _ := ret[0].([]*tfe.WorkspaceTagsByFilterResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTagsByFilter indicates an expected call of AddTagsByFilter.
+func (mr *MockWorkspacesMockRecorder) AddTagsByFilter(ctx, organization, filter, tags, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagsByFilter", reflect.TypeOf((*MockWorkspaces)(nil).AddTagsByFilter), ctx, organization, filter, tags, options)
+}
+
 // AssignSSHKey mocks base method.
 func (m *MockWorkspaces) AssignSSHKey(ctx context.Context, workspaceID string, options tfe.WorkspaceAssignSSHKeyOptions) (*tfe.Workspace, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +166,21 @@ func (mr *MockWorkspacesMockRecorder) ForceUnlock(ctx, workspaceID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceUnlock", reflect.TypeOf((*MockWorkspaces)(nil).ForceUnlock), ctx, workspaceID)
 }
 
+// LatestSuccessfulRun mocks base method.
+func (m *MockWorkspaces) LatestSuccessfulRun(ctx context.Context, workspaceID string) (*tfe.Run, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestSuccessfulRun", ctx, workspaceID)
+	ret0, _ := ret[0].(*tfe.Run)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestSuccessfulRun indicates an expected call of LatestSuccessfulRun.
+func (mr *MockWorkspacesMockRecorder) LatestSuccessfulRun(ctx, workspaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestSuccessfulRun", reflect.TypeOf((*MockWorkspaces)(nil).LatestSuccessfulRun), ctx, workspaceID)
+}
+
 // List mocks base method.
 func (m *MockWorkspaces) List(ctx context.Context, organization string, options *tfe.WorkspaceListOptions) (*tfe.WorkspaceList, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +196,66 @@ func (mr *MockWorkspacesMockRecorder) List(ctx, organization, options interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWorkspaces)(nil).List), ctx, organization, options)
 }
 
+// ListByDescription mocks base method.
+func (m *MockWorkspaces) ListByDescription(ctx context.Context, organization, description string, options *tfe.WorkspaceListOptions) ([]*tfe.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByDescription", ctx, organization, description, options)
+	ret0, _ := ret[0].([]*tfe.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByDescription indicates an expected call of ListByDescription.
+func (mr *MockWorkspacesMockRecorder) ListByDescription(ctx, organization, description, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByDescription", reflect.TypeOf((*MockWorkspaces)(nil).ListByDescription), ctx, organization, description, options)
+}
+
+// ListEffectiveTagBindings mocks base method.
+func (m *MockWorkspaces) ListEffectiveTagBindings(ctx context.Context, workspaceID string) ([]*tfe.EffectiveTagBinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEffectiveTagBindings", ctx, workspaceID)
+	ret0, _ := ret[0].([]*tfe.EffectiveTagBinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEffectiveTagBindings indicates an expected call of ListEffectiveTagBindings.
+func (mr *MockWorkspacesMockRecorder) ListEffectiveTagBindings(ctx, workspaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEffectiveTagBindings", reflect.TypeOf((*MockWorkspaces)(nil).ListEffectiveTagBindings), ctx, workspaceID)
+}
+
+// ListNotificationConfigurations mocks base method.
+func (m *MockWorkspaces) ListNotificationConfigurations(ctx context.Context, workspaceID string, options *tfe.NotificationConfigurationListOptions) (*tfe.NotificationConfigurationList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationConfigurations", ctx, workspaceID, options)
+	ret0, _ := ret[0].(*tfe.NotificationConfigurationList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotificationConfigurations indicates an expected call of ListNotificationConfigurations.
+func (mr *MockWorkspacesMockRecorder) ListNotificationConfigurations(ctx, workspaceID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationConfigurations", reflect.TypeOf((*MockWorkspaces)(nil).ListNotificationConfigurations), ctx, workspaceID, options)
+}
+
+// ListPolicySets mocks base method.
+func (m *MockWorkspaces) ListPolicySets(ctx context.Context, workspaceID string, options *tfe.PolicySetListOptions) ([]*tfe.WorkspacePolicySet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPolicySets", ctx, workspaceID, options)
+	ret0, _ := ret[0].([]*tfe.WorkspacePolicySet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPolicySets indicates an expected call of ListPolicySets.
+func (mr *MockWorkspacesMockRecorder) ListPolicySets(ctx, workspaceID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicySets", reflect.TypeOf((*MockWorkspaces)(nil).ListPolicySets), ctx, workspaceID, options)
+}
+
 // ListRemoteStateConsumers mocks base method.
 func (m *MockWorkspaces) ListRemoteStateConsumers(ctx context.Context, workspaceID string, options *tfe.RemoteStateConsumersListOptions) (*tfe.WorkspaceList, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +271,21 @@ func (mr *MockWorkspacesMockRecorder) ListRemoteStateConsumers(ctx, workspaceID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRemoteStateConsumers", reflect.TypeOf((*MockWorkspaces)(nil).ListRemoteStateConsumers), ctx, workspaceID, options)
 }
 
+// ListRemoteStateConsumersWithDetails mocks base method.
+func (m *MockWorkspaces) ListRemoteStateConsumersWithDetails(ctx context.Context, workspaceID string, options *tfe.RemoteStateConsumersListOptions) ([]*tfe.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRemoteStateConsumersWithDetails", ctx, workspaceID, options)
+	ret0, _ := ret[0].([]*tfe.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRemoteStateConsumersWithDetails indicates an expected call of ListRemoteStateConsumersWithDetails.
+func (mr *MockWorkspacesMockRecorder) ListRemoteStateConsumersWithDetails(ctx, workspaceID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRemoteStateConsumersWithDetails", reflect.TypeOf((*MockWorkspaces)(nil).ListRemoteStateConsumersWithDetails), ctx, workspaceID, options)
+}
+
 // ListTags mocks base method.
 func (m *MockWorkspaces) ListTags(ctx context.Context, workspaceID string, options *tfe.WorkspaceTagListOptions) (*tfe.TagList, error) {
 	m.ctrl.T.Helper()
@@ -211,6 +316,21 @@ func (mr *MockWorkspacesMockRecorder) Lock(ctx, workspaceID, options interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockWorkspaces)(nil).Lock), ctx, workspaceID, options)
 }
 
+// MigrateRemoteStateSharing mocks base method.
+func (m *MockWorkspaces) MigrateRemoteStateSharing(ctx context.Context, workspaceID string) (*tfe.RemoteStateSharingMigration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateRemoteStateSharing", ctx, workspaceID)
+	ret0, _ := ret[0].(*tfe.RemoteStateSharingMigration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateRemoteStateSharing indicates an expected call of MigrateRemoteStateSharing.
+func (mr *MockWorkspacesMockRecorder) MigrateRemoteStateSharing(ctx, workspaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateRemoteStateSharing", reflect.TypeOf((*MockWorkspaces)(nil).MigrateRemoteStateSharing), ctx, workspaceID)
+}
+
 // Read mocks base method.
 func (m *MockWorkspaces) Read(ctx context.Context, organization, workspace string) (*tfe.Workspace, error) {
 	m.ctrl.T.Helper()
@@ -256,6 +376,21 @@ func (mr *MockWorkspacesMockRecorder) ReadByIDWithOptions(ctx, workspaceID, opti
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadByIDWithOptions", reflect.TypeOf((*MockWorkspaces)(nil).ReadByIDWithOptions), ctx, workspaceID, options)
 }
 
+// ReadCurrentRun mocks base method.
+func (m *MockWorkspaces) ReadCurrentRun(ctx context.Context, workspaceID string) (*tfe.Run, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadCurrentRun", ctx, workspaceID)
+	ret0, _ := ret[0].(*tfe.Run)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadCurrentRun indicates an expected call of ReadCurrentRun.
+func (mr *MockWorkspacesMockRecorder) ReadCurrentRun(ctx, workspaceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadCurrentRun", reflect.TypeOf((*MockWorkspaces)(nil).ReadCurrentRun), ctx, workspaceID)
+}
+
 // ReadDataRetentionPolicy mocks base method.
 func (m *MockWorkspaces) ReadDataRetentionPolicy(ctx context.Context, workspaceID string) (*tfe.DataRetentionPolicy, error) {
 	m.ctrl.T.Helper()
@@ -271,6 +406,21 @@ func (mr *MockWorkspacesMockRecorder) ReadDataRetentionPolicy(ctx, workspaceID i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDataRetentionPolicy", reflect.TypeOf((*MockWorkspaces)(nil).ReadDataRetentionPolicy), ctx, workspaceID)
 }
 
+// ReadRunStatistics mocks base method.
+func (m *MockWorkspaces) ReadRunStatistics(ctx context.Context, workspaceID string, options tfe.RunStatisticsOptions) (*tfe.RunStatistics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRunStatistics", ctx, workspaceID, options)
+	ret0, _ := ret[0].(*tfe.RunStatistics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadRunStatistics indicates an expected call of ReadRunStatistics.
+func (mr *MockWorkspacesMockRecorder) ReadRunStatistics(ctx, workspaceID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRunStatistics", reflect.TypeOf((*MockWorkspaces)(nil).ReadRunStatistics), ctx, workspaceID, options)
+}
+
 // ReadWithOptions mocks base method.
 func (m *MockWorkspaces) ReadWithOptions(ctx context.Context, organization, workspace string, options *tfe.WorkspaceReadOptions) (*tfe.Workspace, error) {
 	m.ctrl.T.Helper()
@@ -329,6 +479,21 @@ func (mr *MockWorkspacesMockRecorder) RemoveTags(ctx, workspaceID, options inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTags", reflect.TypeOf((*MockWorkspaces)(nil).RemoveTags), ctx, workspaceID, options)
 }
 
+// RemoveTagsByFilter mocks base method.
+func (m *MockWorkspaces) RemoveTagsByFilter(ctx context.Context, organization string, filter tfe.WorkspaceListOptions, tags []string, options *tfe.WorkspaceTagsByFilterOptions) ([]*tfe.WorkspaceTagsByFilterResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTagsByFilter", ctx, organization, filter, tags, options)
+	ret0, _ := ret[0].([]*tfe.WorkspaceTagsByFilterResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTagsByFilter indicates an expected call of RemoveTagsByFilter.
+func (mr *MockWorkspacesMockRecorder) RemoveTagsByFilter(ctx, organization, filter, tags, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTagsByFilter", reflect.TypeOf((*MockWorkspaces)(nil).RemoveTagsByFilter), ctx, organization, filter, tags, options)
+}
+
 // RemoveVCSConnection mocks base method.
 func (m *MockWorkspaces) RemoveVCSConnection(ctx context.Context, organization, workspace string) (*tfe.Workspace, error) {
 	m.ctrl.T.Helper()
@@ -402,6 +567,21 @@ func (mr *MockWorkspacesMockRecorder) SetDataRetentionPolicy(ctx, workspaceID, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDataRetentionPolicy", reflect.TypeOf((*MockWorkspaces)(nil).SetDataRetentionPolicy), ctx, workspaceID, options)
 }
 
+// TransferToOrganization mocks base method.
+func (m *MockWorkspaces) TransferToOrganization(ctx context.Context, workspaceID, targetOrganization string, options tfe.WorkspaceTransferOptions) (*tfe.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferToOrganization", ctx, workspaceID, targetOrganization, options)
+	ret0, _ := ret[0].(*tfe.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferToOrganization indicates an expected call of TransferToOrganization.
+func (mr *MockWorkspacesMockRecorder) TransferToOrganization(ctx, workspaceID, targetOrganization, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferToOrganization", reflect.TypeOf((*MockWorkspaces)(nil).TransferToOrganization), ctx, workspaceID, targetOrganization, options)
+}
+
 // UnassignSSHKey mocks base method.
 func (m *MockWorkspaces) UnassignSSHKey(ctx context.Context, workspaceID string) (*tfe.Workspace, error) {
 	m.ctrl.T.Helper()
@@ -462,6 +642,21 @@ func (mr *MockWorkspacesMockRecorder) UpdateByID(ctx, workspaceID, options inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByID", reflect.TypeOf((*MockWorkspaces)(nil).UpdateByID), ctx, workspaceID, options)
 }
 
+// UpdateDescription mocks base method.
+func (m *MockWorkspaces) UpdateDescription(ctx context.Context, workspaceID, description string) (*tfe.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDescription", ctx, workspaceID, description)
+	ret0, _ := ret[0].(*tfe.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDescription indicates an expected call of UpdateDescription.
+func (mr *MockWorkspacesMockRecorder) UpdateDescription(ctx, workspaceID, description interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDescription", reflect.TypeOf((*MockWorkspaces)(nil).UpdateDescription), ctx, workspaceID, description)
+}
+
 // UpdateRemoteStateConsumers mocks base method.
 func (m *MockWorkspaces) UpdateRemoteStateConsumers(ctx context.Context, workspaceID string, options tfe.WorkspaceUpdateRemoteStateConsumersOptions) error {
 	m.ctrl.T.Helper()
@@ -475,3 +670,17 @@ func (mr *MockWorkspacesMockRecorder) UpdateRemoteStateConsumers(ctx, workspaceI
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRemoteStateConsumers", reflect.TypeOf((*MockWorkspaces)(nil).UpdateRemoteStateConsumers), ctx, workspaceID, options)
 }
+
+// WaitUntilUnlocked mocks base method.
+func (m *MockWorkspaces) WaitUntilUnlocked(ctx context.Context, workspaceID string, options *tfe.WaitOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitUntilUnlocked", ctx, workspaceID, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilUnlocked indicates an expected call of WaitUntilUnlocked.
+func (mr *MockWorkspacesMockRecorder) WaitUntilUnlocked(ctx, workspaceID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilUnlocked", reflect.TypeOf((*MockWorkspaces)(nil).WaitUntilUnlocked), ctx, workspaceID, options)
+}
@@ -36,6 +36,10 @@ type NotificationConfigurations interface {
 
 	// Verify a notification configuration by its ID.
 	Verify(ctx context.Context, notificationConfigurationID string) (*NotificationConfiguration, error)
+
+	// SetEnabled enables or disables several notification configurations
+	// concurrently.
+	SetEnabled(ctx context.Context, notificationConfigurationIDs []string, enabled bool) ([]*NotificationConfiguration, error)
 }
 
 // notificationConfigurations implements NotificationConfigurations.
@@ -273,6 +277,35 @@ func (s *notificationConfigurations) Update(ctx context.Context, notificationCon
 	return nc, nil
 }
 
+// SetEnabled updates the enabled flag of the given notification configurations,
+// several at a time. It returns the notification configurations that were
+// updated, in the order of their IDs. When some updates fail, the others are
+// still made and a *BatchError is returned along with the updated
+// notification configurations.
+func (s *notificationConfigurations) SetEnabled(ctx context.Context, notificationConfigurationIDs []string, enabled bool) ([]*NotificationConfiguration, error) {
+	for i := range notificationConfigurationIDs {
+		if !validStringID(&notificationConfigurationIDs[i]) {
+			return nil, ErrInvalidNotificationConfigID
+		}
+	}
+
+	updated := make([]*NotificationConfiguration, len(notificationConfigurationIDs))
+	err := doBatch(notificationConfigurationIDs, "update", "notification configuration", func(i int) error {
+		nc, err := s.Update(ctx, notificationConfigurationIDs[i], NotificationConfigurationUpdateOptions{Enabled: Bool(enabled)})
+		updated[i] = nc
+		return err
+	})
+
+	ncs := make([]*NotificationConfiguration, 0, len(updated))
+	for _, nc := range updated {
+		if nc != nil {
+			ncs = append(ncs, nc)
+		}
+	}
+
+	return ncs, err
+}
+
 // Delete a notifications configuration by its ID.
 func (s *notificationConfigurations) Delete(ctx context.Context, notificationConfigurationID string) error {
 	if !validStringID(&notificationConfigurationID) {
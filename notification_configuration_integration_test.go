@@ -5,6 +5,13 @@ package tfe
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -370,3 +377,93 @@ func TestNotificationConfigurationVerify(t *testing.T) {
 		assert.Equal(t, err, ErrInvalidNotificationConfigID)
 	})
 }
+
+func TestNotificationConfigurationSetEnabled(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	defer wTestCleanup()
+
+	ncTest1, ncTest1Cleanup := createNotificationConfiguration(t, client, wTest, nil)
+	defer ncTest1Cleanup()
+	ncTest2, ncTest2Cleanup := createNotificationConfiguration(t, client, wTest, nil)
+	defer ncTest2Cleanup()
+
+	t.Run("when disabling notification configurations", func(t *testing.T) {
+		ncs, err := client.NotificationConfigurations.SetEnabled(ctx, []string{ncTest1.ID, ncTest2.ID}, false)
+		require.NoError(t, err)
+		require.Len(t, ncs, 2)
+		assert.Equal(t, ncTest1.ID, ncs[0].ID)
+		assert.False(t, ncs[0].Enabled)
+		assert.Equal(t, ncTest2.ID, ncs[1].ID)
+		assert.False(t, ncs[1].Enabled)
+	})
+
+	t.Run("when a notification configuration does not exist", func(t *testing.T) {
+		ncs, err := client.NotificationConfigurations.SetEnabled(ctx, []string{ncTest1.ID, "nonexisting"}, true)
+		require.Len(t, ncs, 1)
+		assert.True(t, ncs[0].Enabled)
+
+		var setErr *BatchError
+		require.True(t, errors.As(err, &setErr))
+		assert.Equal(t, ErrResourceNotFound, setErr.Errors["nonexisting"])
+	})
+
+	t.Run("when a notification configuration ID is invalid", func(t *testing.T) {
+		ncs, err := client.NotificationConfigurations.SetEnabled(ctx, []string{ncTest1.ID, badIdentifier}, true)
+		assert.Nil(t, ncs)
+		assert.Equal(t, ErrInvalidNotificationConfigID, err)
+	})
+}
+
+func TestNotificationConfigurationSetEnabled_Concurrent(t *testing.T) {
+	var mu sync.Mutex
+	bodies := make(map[string]string)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		id := strings.TrimPrefix(r.URL.Path, "/api/v2/notification-configurations/")
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		mu.Lock()
+		bodies[id] = string(body)
+		mu.Unlock()
+
+		assert.Equal(t, http.MethodPatch, r.Method)
+		if strings.HasPrefix(id, "nc-missing") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		fmt.Fprintf(w, `{"data":{"id":%q,"type":"notification-configurations","attributes":{"enabled":false}}}`, id)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+
+	var ids []string
+	for i := 0; i < 25; i++ {
+		ids = append(ids, fmt.Sprintf("nc-%d", i))
+	}
+	ids = append(ids, "nc-missing-1", "nc-missing-2")
+
+	ncs, err := client.NotificationConfigurations.SetEnabled(context.Background(), ids, false)
+	require.Len(t, ncs, 25)
+	for i, nc := range ncs {
+		assert.Equal(t, ids[i], nc.ID)
+	}
+
+	var setErr *BatchError
+	require.True(t, errors.As(err, &setErr))
+	assert.Len(t, setErr.Errors, 2)
+	assert.Equal(t, ErrResourceNotFound, setErr.Errors["nc-missing-1"])
+	assert.EqualError(t, err, "failed to update 2 notification configuration(s): nc-missing-1: resource not found; nc-missing-2: resource not found")
+
+	assert.Len(t, bodies, 27)
+	assert.Contains(t, bodies["nc-0"], `"enabled":false`)
+}
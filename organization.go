@@ -46,6 +46,29 @@ type Organizations interface {
 	// ReadRunQueue shows the current run queue of an organization.
 	ReadRunQueue(ctx context.Context, organization string, options ReadRunQueueOptions) (*RunQueue, error)
 
+	// ReadTagBindings reads the effective tag bindings of every project and
+	// workspace within an organization.
+	ReadTagBindings(ctx context.Context, organization string) (*OrganizationTagBindings, error)
+
+	// ReadRunTasksGlobalList lists the run tasks of an organization that are
+	// configured as global run tasks.
+	ReadRunTasksGlobalList(ctx context.Context, organization string) ([]*RunTask, error)
+
+	// UpdateRunTaskStageDefaults sets the enforcement level that new workspace
+	// run tasks of an organization default to in each stage. The API does not
+	// support this, so it always returns an error wrapping ErrNotSupported.
+	UpdateRunTaskStageDefaults(ctx context.Context, organization string, options RunTaskStageDefaultsUpdateOptions) (*RunTaskStageDefaults, error)
+
+	// ReadDefaultProject reads the default project of an organization, in
+	// which workspaces are created when no project is specified.
+	ReadDefaultProject(ctx context.Context, organization string) (*Project, error)
+
+	// ListAvailableTerraformVersions lists the Terraform versions that the
+	// workspaces of an organization can use. The API has no endpoint for
+	// this that non-admins can call, so it always returns an error wrapping
+	// ErrNotSupported.
+	ListAvailableTerraformVersions(ctx context.Context, organization string) ([]string, error)
+
 	// ReadDataRetentionPolicy reads an organization's data retention policy
 	// **Note: This functionality is only available in Terraform Enterprise.**
 	ReadDataRetentionPolicy(ctx context.Context, organization string) (*DataRetentionPolicy, error)
@@ -157,6 +180,16 @@ type RunQueue struct {
 	Items []*Run
 }
 
+// OrganizationTagBindings is an overview of the effective tag bindings of
+// the projects and workspaces within an organization.
+type OrganizationTagBindings struct {
+	// Projects maps each project ID to its effective tag bindings.
+	Projects map[string][]*EffectiveTagBinding
+
+	// Workspaces maps each workspace ID to its effective tag bindings.
+	Workspaces map[string][]*EffectiveTagBinding
+}
+
 // OrganizationPermissions represents the organization permissions.
 type OrganizationPermissions struct {
 	CanCreateTeam               bool `jsonapi:"attr,can-create-team"`
@@ -222,7 +255,11 @@ type OrganizationCreateOptions struct {
 	AllowForceDeleteWorkspaces *bool `jsonapi:"attr,allow-force-delete-workspaces,omitempty"`
 
 	// Optional: DefaultExecutionMode the default execution mode for workspaces
+	// that don't overwrite it. Setting it to `agent` requires DefaultAgentPool.
 	DefaultExecutionMode *string `jsonapi:"attr,default-execution-mode,omitempty"`
+
+	// Optional: DefaultAgentPool default agent pool for workspaces, requires DefaultExecutionMode to be set to `agent`
+	DefaultAgentPool *AgentPool `jsonapi:"relation,default-agent-pool,omitempty"`
 }
 
 // OrganizationUpdateOptions represents the options for updating an organization.
@@ -267,12 +304,27 @@ type OrganizationUpdateOptions struct {
 	AllowForceDeleteWorkspaces *bool `jsonapi:"attr,allow-force-delete-workspaces,omitempty"`
 
 	// Optional: DefaultExecutionMode the default execution mode for workspaces
+	// that don't overwrite it, see WorkspaceSettingOverwritesOptions. Setting
+	// it to `agent` requires DefaultAgentPool.
 	DefaultExecutionMode *string `jsonapi:"attr,default-execution-mode,omitempty"`
 
 	// Optional: DefaultAgentPoolId default agent pool for workspaces, requires DefaultExecutionMode to be set to `agent`
 	DefaultAgentPool *AgentPool `jsonapi:"relation,default-agent-pool,omitempty"`
 }
 
+// RunTaskStageDefaults represents the enforcement level that new workspace
+// run tasks of an organization default to in each stage.
+type RunTaskStageDefaults struct {
+	EnforcementLevels map[Stage]TaskEnforcementLevel
+}
+
+// RunTaskStageDefaultsUpdateOptions represents the options for updating the
+// run task stage defaults of an organization.
+type RunTaskStageDefaultsUpdateOptions struct {
+	// Required: The default enforcement level of each stage.
+	EnforcementLevels map[Stage]TaskEnforcementLevel
+}
+
 // ReadRunQueueOptions represents the options for showing the queue.
 type ReadRunQueueOptions struct {
 	ListOptions
@@ -345,6 +397,9 @@ func (s *organizations) Update(ctx context.Context, organization string, options
 	if !validStringID(&organization) {
 		return nil, ErrInvalidOrg
 	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
 
 	u := fmt.Sprintf("organizations/%s", url.QueryEscape(organization))
 	req, err := s.client.NewRequest("PATCH", u, &options)
@@ -439,6 +494,149 @@ func (s *organizations) ReadRunQueue(ctx context.Context, organization string, o
 	return rq, nil
 }
 
+// ReadTagBindings reads the effective tag bindings of every project and
+// workspace within an organization. It requires one request per project and
+// workspace, so it can take a while on large organizations.
+func (s *organizations) ReadTagBindings(ctx context.Context, organization string) (*OrganizationTagBindings, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	tb := &OrganizationTagBindings{
+		Projects:   make(map[string][]*EffectiveTagBinding),
+		Workspaces: make(map[string][]*EffectiveTagBinding),
+	}
+
+	projectOptions := &ProjectListOptions{}
+	for {
+		pl, err := s.client.Projects.List(ctx, organization, projectOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range pl.Items {
+			bindings, err := s.client.Projects.ListEffectiveTagBindings(ctx, p.ID)
+			if err != nil {
+				return nil, err
+			}
+			tb.Projects[p.ID] = bindings
+		}
+
+		if pl.Pagination == nil || pl.NextPage == 0 {
+			break
+		}
+		projectOptions.PageNumber = pl.NextPage
+	}
+
+	workspaceOptions := &WorkspaceListOptions{}
+	for {
+		wl, err := s.client.Workspaces.List(ctx, organization, workspaceOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, w := range wl.Items {
+			bindings, err := s.client.Workspaces.ListEffectiveTagBindings(ctx, w.ID)
+			if err != nil {
+				return nil, err
+			}
+			tb.Workspaces[w.ID] = bindings
+		}
+
+		if wl.Pagination == nil || wl.NextPage == 0 {
+			break
+		}
+		workspaceOptions.PageNumber = wl.NextPage
+	}
+
+	return tb, nil
+}
+
+// ReadDefaultProject reads the default project of an organization by
+// including it when reading the organization.
+func (s *organizations) ReadDefaultProject(ctx context.Context, organization string) (*Project, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	org, err := s.ReadWithOptions(ctx, organization, OrganizationReadOptions{
+		Include: []OrganizationIncludeOpt{OrganizationDefaultProject},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if org.DefaultProject == nil {
+		return nil, ErrResourceNotFound
+	}
+
+	// Only the ID is known when the project wasn't included in the response.
+	if org.DefaultProject.Name == "" {
+		return s.client.Projects.Read(ctx, org.DefaultProject.ID)
+	}
+
+	return org.DefaultProject, nil
+}
+
+// ReadRunTasksGlobalList lists the global run tasks of an organization.
+func (s *organizations) ReadRunTasksGlobalList(ctx context.Context, organization string) ([]*RunTask, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	var tasks []*RunTask
+	options := &RunTaskListOptions{}
+	for {
+		rl, err := s.client.RunTasks.List(ctx, organization, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range rl.Items {
+			if r.Global != nil && r.Global.Enabled {
+				tasks = append(tasks, r)
+			}
+		}
+
+		if rl.Pagination == nil || rl.NextPage == 0 {
+			break
+		}
+		options.PageNumber = rl.NextPage
+	}
+
+	return tasks, nil
+}
+
+// UpdateRunTaskStageDefaults would set the default enforcement level of
+// workspace run tasks per stage, but the API has no organization defaults for
+// them. Set the stage and enforcement level of each workspace run task with
+// WorkspaceRunTasks.Update, or attach a run task to every workspace by making
+// it a global run task with RunTasks.Update, instead.
+func (s *organizations) UpdateRunTaskStageDefaults(ctx context.Context, organization string, options RunTaskStageDefaultsUpdateOptions) (*RunTaskStageDefaults, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	return nil, ErrRunTaskStageDefaultsNotSupported
+}
+
+// ListAvailableTerraformVersions would list the Terraform versions enabled
+// for an organization, but the API only lists them through the admin
+// Terraform versions endpoint, which non-admins can't call. Admins can use
+// Admin.TerraformVersions.List instead. Others can set the version on the
+// workspace with Workspaces.Update, which the API rejects when the version
+// is not available.
+func (s *organizations) ListAvailableTerraformVersions(ctx context.Context, organization string) ([]string, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	return nil, ErrTerraformVersionsNotSupported
+}
+
 func (s *organizations) ReadDataRetentionPolicy(ctx context.Context, organization string) (*DataRetentionPolicy, error) {
 	if !validStringID(&organization) {
 		return nil, ErrInvalidOrg
@@ -505,5 +703,95 @@ func (o OrganizationCreateOptions) valid() error {
 	if !validString(o.Email) {
 		return ErrRequiredEmail
 	}
+	if o.DefaultExecutionMode != nil {
+		switch *o.DefaultExecutionMode {
+		case "remote", "local", "agent":
+		default:
+			return ErrInvalidDefaultExecutionMode
+		}
+	}
+	if err := validDefaultAgentPool(o.DefaultExecutionMode, o.DefaultAgentPool); err != nil {
+		return err
+	}
+	if !validAuthPolicy(o.CollaboratorAuthPolicy) {
+		return ErrInvalidCollaboratorAuthPolicy
+	}
+	if o.OwnersTeamSAMLRoleID != nil && !validString(o.OwnersTeamSAMLRoleID) {
+		return ErrInvalidOwnersTeamSAMLRoleID
+	}
+	return validSessionSettings(o.SessionRemember, o.SessionTimeout)
+}
+
+func (o OrganizationUpdateOptions) valid() error {
+	if err := validDefaultAgentPool(o.DefaultExecutionMode, o.DefaultAgentPool); err != nil {
+		return err
+	}
+	if !validAuthPolicy(o.CollaboratorAuthPolicy) {
+		return ErrInvalidCollaboratorAuthPolicy
+	}
+	if o.OwnersTeamSAMLRoleID != nil && !validString(o.OwnersTeamSAMLRoleID) {
+		return ErrInvalidOwnersTeamSAMLRoleID
+	}
+	return validSessionSettings(o.SessionRemember, o.SessionTimeout)
+}
+
+func (o RunTaskStageDefaultsUpdateOptions) valid() error {
+	for stage, level := range o.EnforcementLevels {
+		switch stage {
+		case PrePlan, PostPlan, PreApply:
+		default:
+			return ErrInvalidRunTaskStage
+		}
+
+		switch level {
+		case Advisory, Mandatory:
+		default:
+			return ErrInvalidTaskEnforcementLevel
+		}
+	}
+	return nil
+}
+
+// validDefaultAgentPool checks that an agent pool is given for the agent
+// default execution mode, and that its ID is valid.
+func validDefaultAgentPool(mode *string, pool *AgentPool) error {
+	if pool == nil && mode != nil && *mode == "agent" {
+		return ErrRequiredAgentPoolID
+	}
+	if pool != nil && !validStringID(&pool.ID) {
+		return ErrInvalidAgentPoolID
+	}
+	return nil
+}
+
+// validAuthPolicy reports whether policy is unset or one of the supported
+// authentication policies.
+func validAuthPolicy(policy *AuthPolicyType) bool {
+	if policy == nil {
+		return true
+	}
+	switch *policy {
+	case AuthPolicyPassword, AuthPolicyTwoFactor:
+		return true
+	default:
+		return false
+	}
+}
+
+const (
+	minSessionMinutes = 20
+	maxSessionMinutes = 20160
+)
+
+// validSessionSettings checks the session expiration and inactivity timeout,
+// both in minutes, are within the range accepted by the API: 20 minutes to
+// two weeks.
+func validSessionSettings(sessionRemember, sessionTimeout *int) error {
+	if sessionRemember != nil && (*sessionRemember < minSessionMinutes || *sessionRemember > maxSessionMinutes) {
+		return ErrInvalidSessionRemember
+	}
+	if sessionTimeout != nil && (*sessionTimeout < minSessionMinutes || *sessionTimeout > maxSessionMinutes) {
+		return ErrInvalidSessionTimeout
+	}
 	return nil
 }
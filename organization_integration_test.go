@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -299,6 +300,20 @@ func TestOrganizationsUpdate(t *testing.T) {
 		assert.EqualError(t, err, ErrInvalidOrg.Error())
 	})
 
+	t.Run("with out of range session settings", func(t *testing.T) {
+		org, err := client.Organizations.Update(ctx, "my-org", OrganizationUpdateOptions{
+			SessionTimeout: Int(10),
+		})
+		assert.Nil(t, org)
+		assert.Equal(t, err, ErrInvalidSessionTimeout)
+
+		org, err = client.Organizations.Update(ctx, "my-org", OrganizationUpdateOptions{
+			SessionRemember: Int(20161),
+		})
+		assert.Nil(t, org)
+		assert.Equal(t, err, ErrInvalidSessionRemember)
+	})
+
 	t.Run("with agent pool provided, but remote execution mode", func(t *testing.T) {
 		orgTest, orgTestCleanup := createOrganization(t, client)
 		t.Cleanup(orgTestCleanup)
@@ -313,6 +328,33 @@ func TestOrganizationsUpdate(t *testing.T) {
 		assert.ErrorContains(t, err, "Default agent pool must not be specified unless using 'agent' execution mode")
 	})
 
+	t.Run("with agent execution mode, but no agent pool", func(t *testing.T) {
+		org, err := client.Organizations.Update(ctx, "my-org", OrganizationUpdateOptions{
+			DefaultExecutionMode: String("agent"),
+		})
+		assert.Nil(t, org)
+		assert.Equal(t, ErrRequiredAgentPoolID, err)
+	})
+
+	t.Run("with the password collaborator auth policy", func(t *testing.T) {
+		orgTest, orgTestCleanup := createOrganization(t, client)
+		t.Cleanup(orgTestCleanup)
+
+		org, err := client.Organizations.Update(ctx, orgTest.Name, OrganizationUpdateOptions{
+			CollaboratorAuthPolicy: AuthPolicy(AuthPolicyPassword),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, AuthPolicyPassword, org.CollaboratorAuthPolicy)
+	})
+
+	t.Run("with an invalid collaborator auth policy", func(t *testing.T) {
+		org, err := client.Organizations.Update(ctx, "my-org", OrganizationUpdateOptions{
+			CollaboratorAuthPolicy: AuthPolicy("sso_only"),
+		})
+		assert.Nil(t, org)
+		assert.Equal(t, ErrInvalidCollaboratorAuthPolicy, err)
+	})
+
 	t.Run("when only updating a subset of fields", func(t *testing.T) {
 		orgTest, orgTestCleanup := createOrganization(t, client)
 		t.Cleanup(orgTestCleanup)
@@ -350,6 +392,173 @@ func TestOrganizationsUpdate(t *testing.T) {
 	})
 }
 
+func TestOrganizationUpdateOptionsValid(t *testing.T) {
+	t.Run("with agent execution mode and an agent pool", func(t *testing.T) {
+		options := OrganizationUpdateOptions{
+			DefaultExecutionMode: String("agent"),
+			DefaultAgentPool:     &AgentPool{ID: "apool-123"},
+		}
+
+		err := options.valid()
+		assert.Nil(t, err)
+	})
+
+	t.Run("with agent execution mode, but no agent pool", func(t *testing.T) {
+		options := OrganizationUpdateOptions{
+			DefaultExecutionMode: String("agent"),
+		}
+
+		err := options.valid()
+		assert.Equal(t, ErrRequiredAgentPoolID, err)
+	})
+
+	t.Run("with an invalid agent pool ID", func(t *testing.T) {
+		options := OrganizationUpdateOptions{
+			DefaultExecutionMode: String("agent"),
+			DefaultAgentPool:     &AgentPool{ID: badIdentifier},
+		}
+
+		err := options.valid()
+		assert.Equal(t, ErrInvalidAgentPoolID, err)
+	})
+
+	t.Run("with another execution mode", func(t *testing.T) {
+		for _, mode := range []string{"remote", "local"} {
+			options := OrganizationUpdateOptions{
+				DefaultExecutionMode: String(mode),
+			}
+
+			err := options.valid()
+			assert.Nil(t, err)
+		}
+	})
+
+	t.Run("with a collaborator auth policy", func(t *testing.T) {
+		for _, policy := range []AuthPolicyType{AuthPolicyPassword, AuthPolicyTwoFactor} {
+			options := OrganizationUpdateOptions{
+				CollaboratorAuthPolicy: AuthPolicy(policy),
+			}
+
+			err := options.valid()
+			assert.Nil(t, err)
+		}
+	})
+
+	t.Run("with an invalid collaborator auth policy", func(t *testing.T) {
+		options := OrganizationUpdateOptions{
+			CollaboratorAuthPolicy: AuthPolicy("sso_only"),
+		}
+
+		err := options.valid()
+		assert.Equal(t, ErrInvalidCollaboratorAuthPolicy, err)
+
+		createOptions := OrganizationCreateOptions{
+			Name:                   String("my-org"),
+			Email:                  String("info@example.com"),
+			CollaboratorAuthPolicy: AuthPolicy("sso_only"),
+		}
+		assert.Equal(t, ErrInvalidCollaboratorAuthPolicy, createOptions.valid())
+	})
+
+	t.Run("with a default execution mode the client does not know", func(t *testing.T) {
+		options := OrganizationUpdateOptions{
+			DefaultExecutionMode: String("hybrid"),
+		}
+
+		// The API validates the mode of existing organizations.
+		assert.Nil(t, options.valid())
+	})
+}
+
+func TestOrganizationCreateOptionsValid(t *testing.T) {
+	base := func() OrganizationCreateOptions {
+		return OrganizationCreateOptions{
+			Name:  String("my-org"),
+			Email: String("info@example.com"),
+		}
+	}
+
+	t.Run("with governance settings", func(t *testing.T) {
+		options := base()
+		options.CostEstimationEnabled = Bool(true)
+		options.AssessmentsEnforced = Bool(true)
+		options.AllowForceDeleteWorkspaces = Bool(false)
+		options.OwnersTeamSAMLRoleID = String("owners")
+		options.SessionRemember = Int(20160)
+		options.SessionTimeout = Int(20)
+		options.AggregatedCommitStatusEnabled = Bool(true)
+		options.SendPassingStatusesForUntriggeredSpeculativePlans = Bool(false)
+		options.DefaultExecutionMode = String("agent")
+		options.DefaultAgentPool = &AgentPool{ID: "apool-123"}
+
+		assert.Nil(t, options.valid())
+	})
+
+	t.Run("with an invalid default execution mode", func(t *testing.T) {
+		options := base()
+		options.DefaultExecutionMode = String("hybrid")
+		assert.Equal(t, ErrInvalidDefaultExecutionMode, options.valid())
+	})
+
+	t.Run("with agent execution mode, but no agent pool", func(t *testing.T) {
+		options := base()
+		options.DefaultExecutionMode = String("agent")
+		assert.Equal(t, ErrRequiredAgentPoolID, options.valid())
+	})
+
+	t.Run("with an invalid agent pool ID", func(t *testing.T) {
+		options := base()
+		options.DefaultExecutionMode = String("agent")
+		options.DefaultAgentPool = &AgentPool{ID: badIdentifier}
+		assert.Equal(t, ErrInvalidAgentPoolID, options.valid())
+	})
+
+	t.Run("with an empty owners team SAML role ID", func(t *testing.T) {
+		options := base()
+		options.OwnersTeamSAMLRoleID = String("")
+		assert.Equal(t, ErrInvalidOwnersTeamSAMLRoleID, options.valid())
+	})
+
+	t.Run("with aggregated commit statuses and passing statuses for untriggered plans", func(t *testing.T) {
+		options := base()
+		options.AggregatedCommitStatusEnabled = Bool(true)
+		options.SendPassingStatusesForUntriggeredSpeculativePlans = Bool(true)
+		assert.Nil(t, options.valid())
+	})
+
+	t.Run("with an invalid session timeout", func(t *testing.T) {
+		options := base()
+		options.SessionTimeout = Int(10)
+		assert.Equal(t, ErrInvalidSessionTimeout, options.valid())
+	})
+}
+
+func TestOrganizationCreateOptions_Marshal(t *testing.T) {
+	opts := OrganizationCreateOptions{
+		Name:                       String("my-org"),
+		Email:                      String("info@example.com"),
+		CostEstimationEnabled:      Bool(true),
+		AssessmentsEnforced:        Bool(true),
+		AllowForceDeleteWorkspaces: Bool(false),
+		OwnersTeamSAMLRoleID:       String("owners"),
+		SessionRemember:            Int(20160),
+		SessionTimeout:             Int(60),
+		DefaultExecutionMode:       String("agent"),
+		DefaultAgentPool:           &AgentPool{ID: "apool-123"},
+	}
+
+	reqBody, err := serializeRequestBody(&opts)
+	require.NoError(t, err)
+	req, err := retryablehttp.NewRequest("POST", "url", reqBody)
+	require.NoError(t, err)
+	bodyBytes, err := req.BodyBytes()
+	require.NoError(t, err)
+
+	expectedBody := `{"data":{"type":"organizations","attributes":{"allow-force-delete-workspaces":false,"assessments-enforced":true,"cost-estimation-enabled":true,"default-execution-mode":"agent","email":"info@example.com","name":"my-org","owners-team-saml-role-id":"owners","session-remember":20160,"session-timeout":60},"relationships":{"default-agent-pool":{"data":{"type":"agent-pools","id":"apool-123"}}}}}
+`
+	assert.Equal(t, expectedBody, string(bodyBytes))
+}
+
 func TestOrganizationsDelete(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -559,6 +768,157 @@ func TestOrganizationsReadRunQueue(t *testing.T) {
 	})
 }
 
+func TestOrganizationsReadTagBindings(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	pTest, err := client.Projects.Create(ctx, orgTest.Name, ProjectCreateOptions{
+		Name: randomStringWithoutSpecialChar(t),
+		TagBindings: []*TagBinding{
+			{Key: "cost-center", Value: "platform"},
+		},
+	})
+	require.NoError(t, err)
+
+	wTest, wTestCleanup := createWorkspaceWithOptions(t, client, orgTest, WorkspaceCreateOptions{
+		Name:    String(randomString(t)),
+		Project: pTest,
+	})
+	t.Cleanup(wTestCleanup)
+
+	t.Run("with a valid organization", func(t *testing.T) {
+		tb, err := client.Organizations.ReadTagBindings(ctx, orgTest.Name)
+		require.NoError(t, err)
+
+		require.Contains(t, tb.Projects, pTest.ID)
+		require.Len(t, tb.Projects[pTest.ID], 1)
+		assert.Equal(t, "cost-center", tb.Projects[pTest.ID][0].Key)
+		assert.Equal(t, TagBindingSourceProject, tb.Projects[pTest.ID][0].Source)
+
+		require.Contains(t, tb.Workspaces, wTest.ID)
+		require.Len(t, tb.Workspaces[wTest.ID], 1)
+		assert.Equal(t, "platform", tb.Workspaces[wTest.ID][0].Value)
+		assert.Equal(t, TagBindingSourceProject, tb.Workspaces[wTest.ID][0].Source)
+	})
+
+	t.Run("with an invalid organization", func(t *testing.T) {
+		tb, err := client.Organizations.ReadTagBindings(ctx, badIdentifier)
+		assert.Nil(t, tb)
+		assert.EqualError(t, err, ErrInvalidOrg.Error())
+	})
+}
+
+func TestOrganizationsReadDefaultProject(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	t.Run("with a valid organization", func(t *testing.T) {
+		p, err := client.Organizations.ReadDefaultProject(ctx, orgTest.Name)
+		require.NoError(t, err)
+		require.NotNil(t, p)
+		assert.NotEmpty(t, p.ID)
+		assert.Equal(t, "Default Project", p.Name)
+
+		org, err := client.Organizations.Read(ctx, orgTest.Name)
+		require.NoError(t, err)
+		require.NotNil(t, org.DefaultProject)
+		assert.Equal(t, org.DefaultProject.ID, p.ID)
+	})
+
+	t.Run("when the organization does not exist", func(t *testing.T) {
+		p, err := client.Organizations.ReadDefaultProject(ctx, randomString(t))
+		assert.Nil(t, p)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with an invalid organization", func(t *testing.T) {
+		p, err := client.Organizations.ReadDefaultProject(ctx, badIdentifier)
+		assert.Nil(t, p)
+		assert.EqualError(t, err, ErrInvalidOrg.Error())
+	})
+}
+
+func TestOrganizationsReadRunTasksGlobalList(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	upgradeOrganizationSubscription(t, client, orgTest)
+
+	globalTask, globalTaskCleanup := createRunTask(t, client, orgTest)
+	t.Cleanup(globalTaskCleanup)
+
+	_, localTaskCleanup := createRunTask(t, client, orgTest)
+	t.Cleanup(localTaskCleanup)
+
+	_, err := client.RunTasks.Update(ctx, globalTask.ID, RunTaskUpdateOptions{
+		Global: &GlobalRunTaskOptions{
+			Enabled: Bool(true),
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("with a valid organization", func(t *testing.T) {
+		tasks, err := client.Organizations.ReadRunTasksGlobalList(ctx, orgTest.Name)
+		require.NoError(t, err)
+
+		require.Len(t, tasks, 1)
+		assert.Equal(t, globalTask.ID, tasks[0].ID)
+		assert.True(t, tasks[0].Global.Enabled)
+	})
+
+	t.Run("with an invalid organization", func(t *testing.T) {
+		tasks, err := client.Organizations.ReadRunTasksGlobalList(ctx, badIdentifier)
+		assert.Nil(t, tasks)
+		assert.EqualError(t, err, ErrInvalidOrg.Error())
+	})
+}
+
+func TestOrganizationsUpdateRunTaskStageDefaults(t *testing.T) {
+	client := &Client{}
+	client.Organizations = &organizations{client: client}
+	ctx := context.Background()
+
+	t.Run("with valid options", func(t *testing.T) {
+		defaults, err := client.Organizations.UpdateRunTaskStageDefaults(ctx, "my-org", RunTaskStageDefaultsUpdateOptions{
+			EnforcementLevels: map[Stage]TaskEnforcementLevel{
+				PrePlan:  Mandatory,
+				PostPlan: Advisory,
+			},
+		})
+		assert.Nil(t, defaults)
+		assert.ErrorIs(t, err, ErrNotSupported)
+		assert.Equal(t, ErrRunTaskStageDefaultsNotSupported, err)
+	})
+
+	t.Run("with an invalid stage", func(t *testing.T) {
+		_, err := client.Organizations.UpdateRunTaskStageDefaults(ctx, "my-org", RunTaskStageDefaultsUpdateOptions{
+			EnforcementLevels: map[Stage]TaskEnforcementLevel{"post_apply": Advisory},
+		})
+		assert.Equal(t, ErrInvalidRunTaskStage, err)
+	})
+
+	t.Run("with an invalid enforcement level", func(t *testing.T) {
+		_, err := client.Organizations.UpdateRunTaskStageDefaults(ctx, "my-org", RunTaskStageDefaultsUpdateOptions{
+			EnforcementLevels: map[Stage]TaskEnforcementLevel{PrePlan: "strict"},
+		})
+		assert.Equal(t, ErrInvalidTaskEnforcementLevel, err)
+	})
+
+	t.Run("with an invalid organization", func(t *testing.T) {
+		_, err := client.Organizations.UpdateRunTaskStageDefaults(ctx, badIdentifier, RunTaskStageDefaultsUpdateOptions{})
+		assert.Equal(t, ErrInvalidOrg, err)
+	})
+}
+
 func TestOrganization_Unmarshal(t *testing.T) {
 	data := map[string]interface{}{
 		"data": map[string]interface{}{
@@ -748,3 +1108,21 @@ func orgItemsContainsEmail(items []*Organization, email string) bool {
 
 	return hasEmail
 }
+
+func TestOrganizationsListAvailableTerraformVersions(t *testing.T) {
+	client := &Client{}
+	client.Organizations = &organizations{client: client}
+	ctx := context.Background()
+
+	t.Run("with a valid organization", func(t *testing.T) {
+		versions, err := client.Organizations.ListAvailableTerraformVersions(ctx, "my-org")
+		assert.Nil(t, versions)
+		assert.ErrorIs(t, err, ErrNotSupported)
+		assert.Equal(t, ErrTerraformVersionsNotSupported, err)
+	})
+
+	t.Run("with an invalid organization", func(t *testing.T) {
+		_, err := client.Organizations.ListAvailableTerraformVersions(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidOrg, err)
+	})
+}
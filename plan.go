@@ -4,13 +4,23 @@
 package tfe
 
 import (
+	"bufio"
 	"bytes"
+	"container/list"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
+	"unicode"
 )
 
 // Compile-time proof of interface implementation.
@@ -21,22 +31,70 @@ var _ Plans = (*plans)(nil)
 //
 // TFE API docs: https://developer.hashicorp.com/terraform/cloud-docs/api-docs/plans
 type Plans interface {
+	// List the plans of the runs of a workspace.
+	List(ctx context.Context, options PlanListOptions) (*PlanList, error)
+
 	// Read a plan by its ID.
 	Read(ctx context.Context, planID string) (*Plan, error)
 
+	// ReadWithOptions reads a plan by its ID using the options supplied.
+	ReadWithOptions(ctx context.Context, planID string, options PlanReadOptions) (*Plan, error)
+
+	// ReadForRun reads the plan of a run.
+	ReadForRun(ctx context.Context, runID string) (*Plan, error)
+
+	// ReadCostEstimate reads the cost estimate of the run a plan belongs to.
+	ReadCostEstimate(ctx context.Context, planID string) (*CostEstimate, error)
+
 	// Logs retrieves the logs of a plan.
 	Logs(ctx context.Context, planID string) (io.Reader, error)
 
+	// LogsWithOptions retrieves the logs of a plan, polling for new log
+	// output as configured by the options.
+	LogsWithOptions(ctx context.Context, planID string, options PlanLogOptions) (io.Reader, error)
+
+	// ReadDiagnostics reads the warnings and errors reported in the
+	// structured logs of a plan.
+	ReadDiagnostics(ctx context.Context, planID string) ([]Diagnostic, error)
+
 	// Retrieve the JSON execution plan
 	ReadJSONOutput(ctx context.Context, planID string) ([]byte, error)
 
-	// ReadResourceChanges fetch plan changed resources
+	// ReadJSONOutputStruct retrieves the JSON execution plan decoded into a
+	// JSONOutput.
+	ReadJSONOutputStruct(ctx context.Context, planID string) (*JSONOutput, error)
+
+	// ReadResourceChanges fetch plan changed resources, along with the drift
+	// detected on resources since they were last applied.
 	ReadResourceChanges(ctx context.Context, planID string) (*PlanResourceChanges, error)
+
+	// ReadOutputChanges retrieves the changes a plan makes to root module
+	// outputs, keyed by output name.
+	ReadOutputChanges(ctx context.Context, planID string) (map[string]Change, error)
+
+	// StreamResourceChanges decodes the resource changes of a plan one at a
+	// time while they are downloaded, calling fn for each of them.
+	StreamResourceChanges(ctx context.Context, planID string, fn func(ResourceChange) error) error
+
+	// ReadExport finds the export of a plan with the given data type and
+	// writes its data to w.
+	ReadExport(ctx context.Context, planID string, w io.Writer, options PlanReadExportOptions) (*PlanExport, error)
+
+	// WaitForStatus polls a plan until it reaches one of the given statuses,
+	// or PlanFinished when none are given.
+	WaitForStatus(ctx context.Context, planID string, options *WaitOptions, statuses ...PlanStatus) (*Plan, error)
+
+	// Cancel cancels the run of a plan that has not finished yet.
+	Cancel(ctx context.Context, planID string) error
 }
 
 // plans implements Plans.
 type plans struct {
 	client *Client
+
+	// cache holds the plans read with their ETag when Config.PlanCacheSize
+	// is set, and is nil otherwise.
+	cache *planCache
 }
 
 // PlanStatus represents a plan state.
@@ -70,6 +128,132 @@ type Plan struct {
 
 	// Relations
 	Exports []*PlanExport `jsonapi:"relation,exports"`
+	Run     *Run          `jsonapi:"relation,run,omitempty"`
+}
+
+// PlanIncludeOpt represents the available options for include query params.
+type PlanIncludeOpt string
+
+const (
+	PlanRun             PlanIncludeOpt = "run"
+	PlanRunWorkspace    PlanIncludeOpt = "run.workspace"
+	PlanRunCostEstimate PlanIncludeOpt = "run.cost_estimate"
+
+	// PlanRunWorkspaceCurrentStateVersion includes the current state version
+	// of the workspace, whose serial tells whether the state changed since
+	// the plan was made.
+	PlanRunWorkspaceCurrentStateVersion PlanIncludeOpt = "run.workspace.current_state_version"
+)
+
+// PlanList represents a list of plans.
+type PlanList struct {
+	*Pagination
+	Items []*Plan
+}
+
+// PlanListOptions represents the options for listing the plans of a
+// workspace. The plans are listed through the runs of the workspace, so the
+// pagination applies to its runs, most recent first.
+type PlanListOptions struct {
+	ListOptions
+
+	// Required: The name of the organization the workspace belongs to.
+	Organization string `url:"-"`
+
+	// Required: The name of the workspace.
+	Workspace string `url:"-"`
+}
+
+// PlanReadOptions represents the options for reading a plan.
+type PlanReadOptions struct {
+	// Optional: A list of relations to include.
+	Include []PlanIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The ETag of a previous read of the plan, as captured with
+	// ContextWithETag. When the plan has not changed since, ErrNotModified
+	// is returned instead. The plan cache
+	// of the client is not used when this is set.
+	IfNoneMatch string `url:"-"`
+}
+
+// PlanLogOptions represents the options for streaming the logs of a plan.
+type PlanLogOptions struct {
+	// Optional: How long to wait before polling again when no new log output
+	// is available. The delay doubles every five polls without output, up
+	// to MaxBackoff. Defaults to 500 milliseconds.
+	PollInterval time.Duration
+
+	// Optional: The longest delay between polls. Defaults to 2 seconds, or
+	// to PollInterval when that is longer.
+	MaxBackoff time.Duration
+}
+
+// DiagnosticSeverity represents the severity of a diagnostic.
+type DiagnosticSeverity string
+
+// List all available diagnostic severities.
+const (
+	DiagnosticError   DiagnosticSeverity = "error"
+	DiagnosticWarning DiagnosticSeverity = "warning"
+)
+
+// Diagnostic represents a warning or error reported by Terraform in the
+// structured logs of a plan.
+type Diagnostic struct {
+	Severity DiagnosticSeverity `json:"severity"`          // Whether the diagnostic is a warning or an error
+	Summary  string             `json:"summary"`           // Short description of the problem
+	Detail   string             `json:"detail,omitempty"`  // Longer explanation of the problem
+	Address  string             `json:"address,omitempty"` // Address of the resource the diagnostic is about, if any
+	Range    *DiagnosticRange   `json:"range,omitempty"`   // Source code the diagnostic is about, if any
+}
+
+// DiagnosticRange identifies a range of source code in a configuration file.
+type DiagnosticRange struct {
+	Filename string        `json:"filename"` // Path of the file, relative to the configuration directory
+	Start    DiagnosticPos `json:"start"`    // Position of the start of the range
+	End      DiagnosticPos `json:"end"`      // Position just after the end of the range
+}
+
+// DiagnosticPos is a position in a source code file.
+type DiagnosticPos struct {
+	Line   int `json:"line"`   // Line number, starting at 1
+	Column int `json:"column"` // Column number, starting at 1
+	Byte   int `json:"byte"`   // Byte offset, starting at 0
+}
+
+// PlanReadExportOptions represents the options for reading the data of a plan
+// export.
+type PlanReadExportOptions struct {
+	// Optional: The data type of the export to read. Defaults to
+	// PlanExportSentinelMockBundleV0.
+	DataType *PlanExportDataType
+
+	// Optional: How to wait for an export that is not finished yet. If not
+	// set, ErrPlanExportNotReady is returned instead.
+	Wait *WaitOptions
+}
+
+// IsNoOp reports whether the plan would not change anything, neither the
+// resources nor the outputs, so applying it can be skipped. A plan that only
+// changes outputs has HasChanges set while all of its resource counters are
+// zero, and is not a no-op: applying it updates the outputs in the state.
+// See HasOnlyOutputChanges.
+func (p *Plan) IsNoOp() bool {
+	return !p.HasChanges &&
+		p.ResourceAdditions == 0 &&
+		p.ResourceChanges == 0 &&
+		p.ResourceDestructions == 0 &&
+		p.ResourceImports == 0
+}
+
+// HasOnlyOutputChanges reports whether the plan changes root module outputs
+// without changing any resource.
+func (p *Plan) HasOnlyOutputChanges() bool {
+	return p.HasChanges &&
+		p.ResourceAdditions == 0 &&
+		p.ResourceChanges == 0 &&
+		p.ResourceDestructions == 0 &&
+		p.ResourceImports == 0
 }
 
 // PlanStatusTimestamps holds the timestamps for individual plan statuses.
@@ -84,138 +268,1570 @@ type PlanStatusTimestamps struct {
 
 // ResourceChange details changes made to a specific resource within a plan.
 type ResourceChange struct {
-	Address      string      `json:"address"`       // Resource address in the configuration
-	Change       Change      `json:"change"`        // Describes the change applied to the resource
-	Index        interface{} `json:"index"`         // Resource index, can be a string or number
-	Mode         string      `json:"mode"`          // Resource management mode (managed or data)
-	Name         string      `json:"name"`          // Resource name
-	ProviderName string      `json:"provider_name"` // Name of the provider managing the resource
-	Type         string      `json:"type"`          // Type of the resource
+	Address         string             `json:"address"`                    // Resource address in the configuration
+	ModuleAddress   string             `json:"module_address,omitempty"`   // Address of the module containing the resource
+	PreviousAddress string             `json:"previous_address,omitempty"` // Address of the resource before it was moved, if it was
+	ActionReason    ChangeActionReason `json:"action_reason,omitempty"`    // Why the actions were chosen, e.g. why a resource is replaced
+	Change          Change             `json:"change"`                     // Describes the change applied to the resource
+	Deposed         string             `json:"deposed,omitempty"`          // Deposed object key, set when the change applies to a deposed instance
+	Index           interface{}        `json:"index,omitempty"`            // Resource index, can be a string or number
+	Mode            string             `json:"mode"`                       // Resource management mode (managed or data)
+	Name            string             `json:"name"`                       // Resource name
+	ProviderName    string             `json:"provider_name"`              // Name of the provider managing the resource
+	Type            string             `json:"type"`                       // Type of the resource
 }
 
+// ChangeActionReason represents the reason Terraform chose the actions of a
+// resource change.
+type ChangeActionReason string
+
+// List all available change action reasons.
+const (
+	ChangeActionReasonReplaceBecauseTainted         ChangeActionReason = "replace_because_tainted"
+	ChangeActionReasonReplaceBecauseCannotUpdate    ChangeActionReason = "replace_because_cannot_update"
+	ChangeActionReasonReplaceByRequest              ChangeActionReason = "replace_by_request"
+	ChangeActionReasonReplaceByTriggers             ChangeActionReason = "replace_by_triggers"
+	ChangeActionReasonDeleteBecauseNoResourceConfig ChangeActionReason = "delete_because_no_resource_config"
+	ChangeActionReasonDeleteBecauseWrongRepetition  ChangeActionReason = "delete_because_wrong_repetition"
+	ChangeActionReasonDeleteBecauseCountIndex       ChangeActionReason = "delete_because_count_index"
+	ChangeActionReasonDeleteBecauseEachKey          ChangeActionReason = "delete_because_each_key"
+	ChangeActionReasonDeleteBecauseNoModule         ChangeActionReason = "delete_because_no_module"
+	ChangeActionReasonDeleteBecauseNoMoveTarget     ChangeActionReason = "delete_because_no_move_target"
+	ChangeActionReasonReadBecauseConfigUnknown      ChangeActionReason = "read_because_config_unknown"
+	ChangeActionReasonReadBecauseDependencyPending  ChangeActionReason = "read_because_dependency_pending"
+	ChangeActionReasonReadBecauseCheckNested        ChangeActionReason = "read_because_check_nested"
+)
+
 // Change captures the before and after states of a resource, including actions taken.
 type Change struct {
-	Actions         []string    `json:"actions"`          // Actions performed on the resource
-	After           interface{} `json:"after"`            // State of the resource after the change
-	AfterSensitive  interface{} `json:"after_sensitive"`  // Indicates if the "after" state includes sensitive values
-	AfterUnknown    interface{} `json:"after_unknown"`    // Parts of the "after" state that are unknown
-	Before          interface{} `json:"before"`           // State of the resource before the change
-	BeforeSensitive interface{} `json:"before_sensitive"` // Indicates if the "before" state includes sensitive values
+	Actions         []string         `json:"actions"`                    // Actions performed on the resource
+	After           interface{}      `json:"after"`                      // State of the resource after the change
+	AfterSensitive  interface{}      `json:"after_sensitive"`            // Indicates if the "after" state includes sensitive values
+	AfterUnknown    interface{}      `json:"after_unknown"`              // Parts of the "after" state that are unknown
+	Before          interface{}      `json:"before"`                     // State of the resource before the change
+	BeforeSensitive interface{}      `json:"before_sensitive"`           // Indicates if the "before" state includes sensitive values
+	ReplacePaths    [][]interface{}  `json:"replace_paths,omitempty"`    // Attribute paths that forced the resource to be replaced
+	Importing       *ChangeImporting `json:"importing,omitempty"`        // Set when the resource is being imported
+	GeneratedConfig string           `json:"generated_config,omitempty"` // Configuration generated for the imported resource
 }
 
-// PlanResourceChanges encapsulates all resource changes within a plan.
-type PlanResourceChanges struct {
-	ResourceChanges []ResourceChange `json:"resource_changes"` // Collection of resource changes
+// ChangeImporting describes the import of a resource by a plan.
+type ChangeImporting struct {
+	ID string `json:"id"` // ID of the remote object being imported
 }
 
-// Read a plan by its ID.
-func (s *plans) Read(ctx context.Context, planID string) (*Plan, error) {
-	if !validStringID(&planID) {
-		return nil, ErrInvalidPlanID
-	}
+// IsReplace reports whether the change replaces the resource, in either order.
+func (c Change) IsReplace() bool {
+	return len(c.Actions) == 2 &&
+		((c.Actions[0] == "delete" && c.Actions[1] == "create") ||
+			(c.Actions[0] == "create" && c.Actions[1] == "delete"))
+}
 
-	u := fmt.Sprintf("plans/%s", url.QueryEscape(planID))
-	req, err := s.client.NewRequest("GET", u, nil)
-	if err != nil {
-		return nil, err
+// IsCreateBeforeDestroy reports whether the change replaces the resource by
+// creating the new object before destroying the old one, as happens with the
+// create_before_destroy lifecycle setting.
+func (c Change) IsCreateBeforeDestroy() bool {
+	return c.IsReplace() && c.Actions[0] == "create"
+}
+
+// Action returns the single action the change performs: "no-op", "create",
+// "read", "update", "delete" or "replace". A change whose actions are not
+// recognized returns them joined by commas.
+func (c Change) Action() string {
+	if c.IsReplace() {
+		return "replace"
 	}
+	return strings.Join(c.Actions, ",")
+}
 
-	p := &Plan{}
-	err = req.Do(ctx, p)
-	if err != nil {
-		return nil, err
+// SensitivePaths returns the sorted paths of the attributes marked sensitive
+// in either the before or the after state of the change, such as "password",
+// "tags.Secret" or "ingress[0].cidr_blocks". Keys that are not valid
+// identifiers are quoted, as in `tags["team/owner"]`. An empty path means the
+// whole value is sensitive.
+func (c Change) SensitivePaths() []string {
+	seen := make(map[string]bool)
+	collectMarkedPaths(c.BeforeSensitive, "", seen)
+	collectMarkedPaths(c.AfterSensitive, "", seen)
+
+	paths := make([]string, 0, len(seen))
+	for path := range seen {
+		paths = append(paths, path)
 	}
+	sort.Strings(paths)
 
-	return p, nil
+	return paths
 }
 
-// Logs retrieves the logs of a plan.
-func (s *plans) Logs(ctx context.Context, planID string) (io.Reader, error) {
-	if !validStringID(&planID) {
-		return nil, ErrInvalidPlanID
+// collectMarkedPaths walks a before_sensitive, after_sensitive or
+// after_unknown value, which mirrors the structure of the state with true at
+// every marked value.
+func collectMarkedPaths(v interface{}, path string, paths map[string]bool) {
+	switch v := v.(type) {
+	case bool:
+		if v {
+			paths[path] = true
+		}
+	case map[string]interface{}:
+		for key, child := range v {
+			collectMarkedPaths(child, attributePath(path, key), paths)
+		}
+	case []interface{}:
+		for i, child := range v {
+			collectMarkedPaths(child, fmt.Sprintf("%s[%d]", path, i), paths)
+		}
 	}
+}
 
-	// Get the plan to make sure it exists.
-	p, err := s.Read(ctx, planID)
-	if err != nil {
-		return nil, err
+// RenderDiff renders the change as a line-oriented diff sorted by attribute
+// path. Added attributes are prefixed with "+", removed ones with "-" and
+// updated ones with "~", showing the value before and after the change.
+// Values only known after apply and sensitive values are printed as
+// "(known after apply)" and "(sensitive value)". Unchanged attributes, null
+// values and empty collections are left out.
+func (c Change) RenderDiff() string {
+	before := flattenDiffValues(c.Before, c.BeforeSensitive, nil)
+	after := flattenDiffValues(c.After, c.AfterSensitive, c.AfterUnknown)
+
+	paths := make([]string, 0, len(before)+len(after))
+	for path := range before {
+		paths = append(paths, path)
 	}
+	for path := range after {
+		if _, ok := before[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
 
-	// Return an error if the log URL is empty.
-	if p.LogReadURL == "" {
-		return nil, fmt.Errorf("plan %s does not have a log URL", planID)
+	var b strings.Builder
+	for _, path := range paths {
+		bv, inBefore := before[path]
+		av, inAfter := after[path]
+		switch {
+		case !inBefore:
+			fmt.Fprintf(&b, "+ %s = %s\n", path, av.rendered)
+		case !inAfter:
+			fmt.Fprintf(&b, "- %s = %s\n", path, bv.rendered)
+		case av.unknown || !reflect.DeepEqual(bv.raw, av.raw):
+			fmt.Fprintf(&b, "~ %s = %s -> %s\n", path, bv.rendered, av.rendered)
+		}
 	}
 
-	u, err := url.Parse(p.LogReadURL)
-	if err != nil {
-		return nil, fmt.Errorf("invalid log URL: %w", err)
+	return b.String()
+}
+
+// diffValue is the value of an attribute path in a rendered diff.
+type diffValue struct {
+	raw      interface{} // Value from the plan, compared to find changes
+	rendered string      // Value as printed in the diff
+	unknown  bool        // Whether the value is only known after apply
+}
+
+// flattenDiffValues flattens a before or after state into its values keyed by
+// attribute path. Sensitive and unknown values are kept whole at the path
+// they are marked at.
+func flattenDiffValues(v, sensitive, unknown interface{}) map[string]diffValue {
+	sensitivePaths := make(map[string]bool)
+	collectMarkedPaths(sensitive, "", sensitivePaths)
+	unknownPaths := make(map[string]bool)
+	collectMarkedPaths(unknown, "", unknownPaths)
+
+	values := make(map[string]diffValue)
+	flattenDiffValue(v, "", sensitivePaths, unknownPaths, values)
+
+	// Unknown attributes are usually left out of the after state altogether.
+	for path := range unknownPaths {
+		values[path] = diffValue{rendered: "(known after apply)", unknown: true}
 	}
 
-	done := func() (bool, error) {
-		p, err := s.Read(ctx, p.ID)
+	return values
+}
+
+func flattenDiffValue(v interface{}, path string, sensitive, unknown map[string]bool, values map[string]diffValue) {
+	if unknown[path] {
+		return
+	}
+	if sensitive[path] {
+		values[path] = diffValue{raw: v, rendered: "(sensitive value)"}
+		return
+	}
+
+	switch v := v.(type) {
+	case nil:
+	case map[string]interface{}:
+		for key, child := range v {
+			flattenDiffValue(child, attributePath(path, key), sensitive, unknown, values)
+		}
+	case []interface{}:
+		for i, child := range v {
+			flattenDiffValue(child, fmt.Sprintf("%s[%d]", path, i), sensitive, unknown, values)
+		}
+	default:
+		rendered, err := json.Marshal(v)
 		if err != nil {
-			return false, err
+			rendered = []byte(fmt.Sprint(v))
 		}
+		values[path] = diffValue{raw: v, rendered: string(rendered)}
+	}
+}
 
-		switch p.Status {
-		case PlanCanceled, PlanErrored, PlanFinished, PlanUnreachable:
-			return true, nil
+// attributePath appends key to path, using an index for keys that are not
+// valid identifiers.
+func attributePath(path, key string) string {
+	valid := key != ""
+	for i, c := range key {
+		if c != '_' && c != '-' && !unicode.IsLetter(c) && (i == 0 || !unicode.IsDigit(c)) {
+			valid = false
+			break
+		}
+	}
+
+	switch {
+	case !valid:
+		return path + "[" + strconv.Quote(key) + "]"
+	case path == "":
+		return key
+	default:
+		return path + "." + key
+	}
+}
+
+// PlanResourceChanges encapsulates all resource changes within a plan.
+type PlanResourceChanges struct {
+	ResourceChanges    []ResourceChange   `json:"resource_changes,omitempty"`    // Collection of resource changes
+	ResourceDrift      []ResourceChange   `json:"resource_drift,omitempty"`      // Changes made to resources outside of Terraform, which the plan doesn't make
+	RelevantAttributes []ResourceAttr     `json:"relevant_attributes,omitempty"` // Attributes that drifted and contributed to the plan
+	Configuration      *PlanConfiguration `json:"configuration,omitempty"`       // Configuration the plan was created from
+}
+
+// SensitiveResources returns the addresses of the resources whose change has
+// sensitive values before or after the change, in the order of the plan. The
+// sensitivity of a value may be marked on the whole value or on any attribute
+// nested in it.
+func (p *PlanResourceChanges) SensitiveResources() []string {
+	var addresses []string
+	for _, rc := range p.ResourceChanges {
+		if hasMarkedValue(rc.Change.BeforeSensitive) || hasMarkedValue(rc.Change.AfterSensitive) {
+			addresses = append(addresses, rc.Address)
+		}
+	}
+	return addresses
+}
+
+// hasMarkedValue reports whether a before_sensitive, after_sensitive or
+// after_unknown value marks any value. See collectMarkedPaths.
+func hasMarkedValue(v interface{}) bool {
+	switch v := v.(type) {
+	case bool:
+		return v
+	case map[string]interface{}:
+		for _, child := range v {
+			if hasMarkedValue(child) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, child := range v {
+			if hasMarkedValue(child) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ResourceAttr identifies an attribute of a resource that changed outside of
+// Terraform and is referenced by the configuration.
+type ResourceAttr struct {
+	Resource  string        `json:"resource"`  // Address of the resource
+	Attribute []interface{} `json:"attribute"` // Path to the attribute, made of attribute names and indexes
+}
+
+// JSONOutput represents the JSON execution plan of a plan, as printed by
+// `terraform show -json`.
+//
+// The resource changes, drift, relevant attributes and configuration are
+// decoded into the embedded PlanResourceChanges, as returned by
+// ReadResourceChanges.
+type JSONOutput struct {
+	PlanResourceChanges
+
+	FormatVersion    string                  `json:"format_version"`           // Version of the JSON plan format
+	TerraformVersion string                  `json:"terraform_version"`        // Version of Terraform that created the plan
+	Variables        map[string]PlanVariable `json:"variables,omitempty"`      // Values of the root module input variables, keyed by name
+	PlannedValues    *PlanStateValues        `json:"planned_values,omitempty"` // State the plan would produce once applied
+	PriorState       *PlanState              `json:"prior_state,omitempty"`    // State the plan was created from, after refreshing
+	OutputChanges    map[string]Change       `json:"output_changes,omitempty"` // Changes the plan makes to root module outputs, keyed by name
+	Checks           []PlanCheck             `json:"checks,omitempty"`         // Results of the custom conditions and check blocks of the configuration
+}
+
+// IsNoOp reports whether the JSON plan changes neither resources nor outputs.
+// Reading data sources doesn't count as a change.
+func (o *JSONOutput) IsNoOp() bool {
+	for _, rc := range o.ResourceChanges {
+		if rc.Change.Importing != nil {
+			return false
+		}
+		switch rc.Change.Action() {
+		case "no-op", "read":
 		default:
-			return false, nil
+			return false
+		}
+	}
+	for _, c := range o.OutputChanges {
+		if c.Action() != "no-op" {
+			return false
+		}
+	}
+	return true
+}
+
+// FailedChecks returns the checks of the JSON plan that failed or could not
+// be evaluated because of an error.
+func (o *JSONOutput) FailedChecks() []PlanCheck {
+	var failed []PlanCheck
+	for _, c := range o.Checks {
+		if c.Status == CheckFail || c.Status == CheckError {
+			failed = append(failed, c)
 		}
 	}
+	return failed
+}
 
-	return &LogReader{
-		client: s.client,
-		ctx:    ctx,
-		done:   done,
-		logURL: u,
-	}, nil
+// CheckStatus represents the status of a check.
+type CheckStatus string
+
+// List all available check statuses.
+const (
+	CheckPass    CheckStatus = "pass"
+	CheckFail    CheckStatus = "fail"
+	CheckError   CheckStatus = "error"
+	CheckUnknown CheckStatus = "unknown"
+)
+
+// PlanCheck is the result of the custom conditions of a resource or output,
+// or of the assertions of a check block, over all of its instances.
+type PlanCheck struct {
+	Address   PlanCheckAddress    `json:"address"`             // Object the check belongs to
+	Status    CheckStatus         `json:"status"`              // Aggregated status of the instances of the object
+	Instances []PlanCheckInstance `json:"instances,omitempty"` // Status of each instance, once the instances are known
 }
 
-// Retrieve the JSON execution plan
-func (s *plans) ReadJSONOutput(ctx context.Context, planID string) ([]byte, error) {
-	if !validStringID(&planID) {
-		return nil, ErrInvalidPlanID
+// PlanCheckAddress identifies the object a check belongs to.
+type PlanCheckAddress struct {
+	Kind      string `json:"kind"`             // Kind of object: "resource", "output_value" or "check"
+	ToDisplay string `json:"to_display"`       // Address of the object, e.g. aws_instance.web
+	Mode      string `json:"mode,omitempty"`   // Mode of a resource, managed or data
+	Type      string `json:"type,omitempty"`   // Type of a resource
+	Name      string `json:"name"`             // Name of the object
+	Module    string `json:"module,omitempty"` // Address of the module the object is declared in, if not the root module
+}
+
+// PlanCheckInstance is the result of the checks of an instance of an object.
+type PlanCheckInstance struct {
+	Address  PlanCheckInstanceAddress `json:"address"`            // Instance the result is for
+	Status   CheckStatus              `json:"status"`             // Status of the checks of the instance
+	Problems []PlanCheckProblem       `json:"problems,omitempty"` // Error messages of the conditions that failed
+}
+
+// PlanCheckInstanceAddress identifies an instance of the object of a check.
+type PlanCheckInstanceAddress struct {
+	ToDisplay   string      `json:"to_display"`             // Address of the instance, e.g. aws_instance.web[0]
+	Module      string      `json:"module,omitempty"`       // Address of the module instance, if not the root module
+	InstanceKey interface{} `json:"instance_key,omitempty"` // Key of the instance when count or for_each is used
+}
+
+// PlanCheckProblem describes why a condition failed.
+type PlanCheckProblem struct {
+	Message string `json:"message"` // Error message of the condition
+}
+
+// FailureMessages returns the error messages of the conditions that failed,
+// over all instances.
+func (c PlanCheck) FailureMessages() []string {
+	var messages []string
+	for _, instance := range c.Instances {
+		for _, problem := range instance.Problems {
+			messages = append(messages, problem.Message)
+		}
 	}
+	return messages
+}
 
-	u := fmt.Sprintf("plans/%s/json-output", url.QueryEscape(planID))
-	req, err := s.client.NewRequest("GET", u, nil)
-	if err != nil {
-		return nil, err
+// PlanVariable represents the value of an input variable of a plan.
+type PlanVariable struct {
+	Value json.RawMessage `json:"value"` // Value of the variable
+}
+
+// PlanState represents a state embedded in a JSON plan, such as its prior
+// state.
+type PlanState struct {
+	FormatVersion    string           `json:"format_version,omitempty"`    // Version of the JSON state format
+	TerraformVersion string           `json:"terraform_version,omitempty"` // Version of Terraform that wrote the state
+	Values           *PlanStateValues `json:"values,omitempty"`            // Outputs and resources of the state
+}
+
+// PlanStateValues represents the values of the resources and outputs of a
+// state, such as the planned values of a plan.
+type PlanStateValues struct {
+	Outputs    map[string]PlanStateOutput `json:"outputs,omitempty"` // Root module outputs, keyed by name
+	RootModule PlanStateModule            `json:"root_module"`       // Resources of the root module and its child modules
+}
+
+// PlanStateOutput represents the value of an output in a state.
+type PlanStateOutput struct {
+	Sensitive bool            `json:"sensitive"`       // Whether the output is sensitive
+	Type      json.RawMessage `json:"type,omitempty"`  // Type constraint of the output value
+	Value     json.RawMessage `json:"value,omitempty"` // Value of the output, not set while unknown
+}
+
+// PlanStateModule represents the resources of a module in a state.
+type PlanStateModule struct {
+	Address      string              `json:"address,omitempty"`       // Module address, empty for the root module
+	Resources    []PlanStateResource `json:"resources,omitempty"`     // Resources of the module
+	ChildModules []PlanStateModule   `json:"child_modules,omitempty"` // Modules called by the module
+}
+
+// AllResources returns the resources of the module and of all the modules it
+// calls, directly or not, with the resources of a module before those of its
+// child modules.
+func (m PlanStateModule) AllResources() []PlanStateResource {
+	resources := append([]PlanStateResource(nil), m.Resources...)
+	for _, child := range m.ChildModules {
+		resources = append(resources, child.AllResources()...)
 	}
 
-	var buf bytes.Buffer
-	err = req.Do(ctx, &buf)
-	if err != nil {
-		return nil, err
+	return resources
+}
+
+// PlanStateResource represents a resource instance in a state.
+type PlanStateResource struct {
+	Address         string          `json:"address"`                    // Absolute resource address
+	Mode            string          `json:"mode"`                       // Resource management mode (managed or data)
+	Type            string          `json:"type"`                       // Type of the resource
+	Name            string          `json:"name"`                       // Resource name
+	Index           interface{}     `json:"index,omitempty"`            // Resource index, can be a string or number
+	ProviderName    string          `json:"provider_name"`              // Name of the provider managing the resource
+	SchemaVersion   int             `json:"schema_version"`             // Version of the resource type schema
+	Values          json.RawMessage `json:"values,omitempty"`           // Attribute values of the resource, as an object
+	SensitiveValues json.RawMessage `json:"sensitive_values,omitempty"` // Which attribute values are sensitive
+	DependsOn       []string        `json:"depends_on,omitempty"`       // Addresses of the resources the resource depends on
+	Tainted         bool            `json:"tainted,omitempty"`          // Whether the resource is tainted
+	DeposedKey      string          `json:"deposed_key,omitempty"`      // Deposed object key, set for deposed instances
+}
+
+// PlanConfiguration represents the configuration block of a JSON plan, which
+// describes the Terraform configuration the plan was created from.
+type PlanConfiguration struct {
+	ProviderConfigs map[string]ProviderConfig `json:"provider_config,omitempty"` // Provider configurations, keyed by provider config key
+	RootModule      ConfigModule              `json:"root_module"`               // The root module of the configuration
+}
+
+// ProviderConfig represents a provider block in the configuration.
+type ProviderConfig struct {
+	Name              string                     `json:"name"`                         // Local name of the provider
+	FullName          string                     `json:"full_name,omitempty"`          // Fully qualified provider source address
+	Alias             string                     `json:"alias,omitempty"`              // Provider alias
+	ModuleAddress     string                     `json:"module_address,omitempty"`     // Address of the module declaring the provider
+	VersionConstraint string                     `json:"version_constraint,omitempty"` // Version constraint of the provider
+	Expressions       map[string]json.RawMessage `json:"expressions,omitempty"`        // Arguments of the provider block
+}
+
+// ConfigModule represents a module in the configuration.
+type ConfigModule struct {
+	Outputs     map[string]ConfigOutput     `json:"outputs,omitempty"`      // Output blocks, keyed by name
+	Resources   []ConfigResource            `json:"resources,omitempty"`    // Resource and data blocks
+	ModuleCalls map[string]ConfigModuleCall `json:"module_calls,omitempty"` // Module blocks, keyed by name
+	Variables   map[string]ConfigVariable   `json:"variables,omitempty"`    // Variable blocks, keyed by name
+}
+
+// ConfigResource represents a resource or data block in the configuration.
+type ConfigResource struct {
+	Address           string                     `json:"address"`                       // Address of the resource within its module
+	Mode              string                     `json:"mode"`                          // Resource management mode (managed or data)
+	Type              string                     `json:"type"`                          // Type of the resource
+	Name              string                     `json:"name"`                          // Resource name
+	ProviderConfigKey string                     `json:"provider_config_key"`           // Key of the provider in PlanConfiguration.ProviderConfigs
+	Expressions       map[string]json.RawMessage `json:"expressions,omitempty"`         // Arguments and nested blocks of the resource block
+	SchemaVersion     int                        `json:"schema_version"`                // Version of the resource type schema
+	CountExpression   *ConfigExpression          `json:"count_expression,omitempty"`    // The count argument, if any
+	ForEachExpression *ConfigExpression          `json:"for_each_expression,omitempty"` // The for_each argument, if any
+	DependsOn         []string                   `json:"depends_on,omitempty"`          // Explicit dependencies of the resource
+}
+
+// ConfigModuleCall represents a module block in the configuration.
+type ConfigModuleCall struct {
+	Source            string                     `json:"source"`                        // Source address of the module
+	VersionConstraint string                     `json:"version_constraint,omitempty"`  // Version constraint of the module
+	Expressions       map[string]json.RawMessage `json:"expressions,omitempty"`         // Input variables passed to the module
+	CountExpression   *ConfigExpression          `json:"count_expression,omitempty"`    // The count argument, if any
+	ForEachExpression *ConfigExpression          `json:"for_each_expression,omitempty"` // The for_each argument, if any
+	Module            ConfigModule               `json:"module"`                        // The configuration of the called module
+	DependsOn         []string                   `json:"depends_on,omitempty"`          // Explicit dependencies of the module
+}
+
+// ConfigOutput represents an output block in the configuration.
+type ConfigOutput struct {
+	Expression  ConfigExpression `json:"expression"`            // The value of the output
+	Description string           `json:"description,omitempty"` // Description of the output
+	Sensitive   bool             `json:"sensitive,omitempty"`   // Whether the output is sensitive
+	DependsOn   []string         `json:"depends_on,omitempty"`  // Explicit dependencies of the output
+}
+
+// ConfigVariable represents a variable block in the configuration.
+type ConfigVariable struct {
+	Default     json.RawMessage `json:"default,omitempty"`     // Default value of the variable
+	Description string          `json:"description,omitempty"` // Description of the variable
+	Sensitive   bool            `json:"sensitive,omitempty"`   // Whether the variable is sensitive
+}
+
+// ConfigExpression represents a single expression in the configuration. An
+// expression is either a constant value or references other objects.
+type ConfigExpression struct {
+	ConstantValue json.RawMessage `json:"constant_value,omitempty"` // Value of the expression when it is a constant
+	References    []string        `json:"references,omitempty"`     // Addresses of the objects the expression refers to
+}
+
+// References returns the sorted addresses referenced by any of the
+// expressions of the resource, including expressions in nested blocks.
+func (r ConfigResource) References() []string {
+	seen := make(map[string]bool)
+
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			if refs, ok := v["references"].([]interface{}); ok {
+				for _, ref := range refs {
+					if s, ok := ref.(string); ok {
+						seen[s] = true
+					}
+				}
+			}
+			for k, e := range v {
+				if k != "references" && k != "constant_value" {
+					walk(e)
+				}
+			}
+		case []interface{}:
+			for _, e := range v {
+				walk(e)
+			}
+		}
 	}
 
-	return buf.Bytes(), nil
+	for _, raw := range r.Expressions {
+		var v interface{}
+		if err := json.Unmarshal(raw, &v); err == nil {
+			walk(v)
+		}
+	}
+
+	refs := make([]string, 0, len(seen))
+	for ref := range seen {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+
+	return refs
 }
 
-// ReadResourceChanges fetch plan changed resources
-func (s *plans) ReadResourceChanges(ctx context.Context, planID string) (*PlanResourceChanges, error) {
-	if !validStringID(&planID) {
-		return nil, ErrInvalidPlanID
+// WriteNDJSON writes the resource changes to w as newline delimited JSON,
+// one resource change per line.
+func (p *PlanResourceChanges) WriteNDJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	for _, rc := range p.ResourceChanges {
+		if err := enc.Encode(rc); err != nil {
+			return err
+		}
 	}
 
-	u := fmt.Sprintf("plans/%s/json-output-redacted", url.QueryEscape(planID))
-	req, err := s.client.NewRequest("GET", u, nil)
-	if err != nil {
-		return nil, err
+	return nil
+}
+
+// PlanChangeSummary counts the resource changes of a plan the same way
+// Terraform does in its plan summary, so a replaced resource counts as both
+// an addition and a destruction.
+type PlanChangeSummary struct {
+	Add     int
+	Change  int
+	Destroy int
+}
+
+// CountByModule groups the resource changes by the module path parsed from
+// their address, e.g. "module.network" or "module.network.module.subnets".
+// Resources in the root module are grouped under the empty string.
+func (p *PlanResourceChanges) CountByModule() map[string]PlanChangeSummary {
+	counts := make(map[string]PlanChangeSummary)
+	for _, rc := range p.ResourceChanges {
+		module := moduleAddress(rc.Address)
+		summary := counts[module]
+
+		switch strings.Join(rc.Change.Actions, ",") {
+		case "create":
+			summary.Add++
+		case "update":
+			summary.Change++
+		case "delete":
+			summary.Destroy++
+		case "delete,create", "create,delete":
+			summary.Add++
+			summary.Destroy++
+		}
+
+		counts[module] = summary
 	}
 
-	var buf bytes.Buffer
-	err = req.Do(ctx, &buf)
-	if err != nil {
-		return nil, err
+	return counts
+}
+
+// Imports returns the import ID of each resource the plan imports, keyed by
+// resource address.
+func (p *PlanResourceChanges) Imports() map[string]string {
+	imports := make(map[string]string)
+	for _, rc := range p.ResourceChanges {
+		if rc.Change.Importing != nil {
+			imports[rc.Address] = rc.Change.Importing.ID
+		}
 	}
 
-	var resourceChanges PlanResourceChanges
-	if err := json.Unmarshal(buf.Bytes(), &resourceChanges); err != nil {
-		return nil, err
+	return imports
+}
+
+// ChangesByAction returns the resource changes performing the given action,
+// as returned by Change.Action. Replacements match "replace" regardless of
+// whether the resource is destroyed before or after it is created.
+func (p *PlanResourceChanges) ChangesByAction(action string) []ResourceChange {
+	var changes []ResourceChange
+	for _, rc := range p.ResourceChanges {
+		if rc.Change.Action() == action {
+			changes = append(changes, rc)
+		}
 	}
 
-	return &resourceChanges, nil
+	return changes
+}
+
+// ChangesByMode returns the resource changes of resources with the given
+// management mode, "managed" or "data".
+func (p *PlanResourceChanges) ChangesByMode(mode string) []ResourceChange {
+	var changes []ResourceChange
+	for _, rc := range p.ResourceChanges {
+		if rc.Mode == mode {
+			changes = append(changes, rc)
+		}
+	}
+
+	return changes
+}
+
+// HasDestructiveChanges reports whether the plan deletes or replaces any
+// resource.
+func (p *PlanResourceChanges) HasDestructiveChanges() bool {
+	for _, rc := range p.ResourceChanges {
+		if action := rc.Change.Action(); action == "delete" || action == "replace" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PlanDiff describes how the resource changes of two plans differ. Each list
+// is sorted by address.
+type PlanDiff struct {
+	Added   []ResourceChange         // Resource changes only found in the second plan
+	Removed []ResourceChange         // Resource changes only found in the first plan
+	Changed []PlanResourceChangeDiff // Resource changes found in both plans that differ
+}
+
+// PlanResourceChangeDiff holds the two differing versions of the change of a
+// resource.
+type PlanResourceChangeDiff struct {
+	Address string
+	Before  ResourceChange // The resource change in the first plan
+	After   ResourceChange // The resource change in the second plan
+}
+
+// IsEmpty reports whether both plans make the same resource changes.
+func (d PlanDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// ComparePlans compares the resource changes of two plans, matching them by
+// address and deposed key. Two resource changes are the same when their
+// actions, action reason and before and after values are equal. A nil plan
+// is treated as one without resource changes.
+func ComparePlans(a, b *PlanResourceChanges) PlanDiff {
+	before := resourceChangesByKey(a)
+	after := resourceChangesByKey(b)
+
+	var diff PlanDiff
+	for key, rc := range before {
+		other, ok := after[key]
+		switch {
+		case !ok:
+			diff.Removed = append(diff.Removed, rc)
+		case rc.ActionReason != other.ActionReason || !reflect.DeepEqual(rc.Change, other.Change):
+			diff.Changed = append(diff.Changed, PlanResourceChangeDiff{
+				Address: rc.Address,
+				Before:  rc,
+				After:   other,
+			})
+		}
+	}
+	for key, rc := range after {
+		if _, ok := before[key]; !ok {
+			diff.Added = append(diff.Added, rc)
+		}
+	}
+
+	sortResourceChanges(diff.Added)
+	sortResourceChanges(diff.Removed)
+	sort.Slice(diff.Changed, func(i, j int) bool {
+		return resourceChangeLess(diff.Changed[i].Before, diff.Changed[j].Before)
+	})
+
+	return diff
+}
+
+// resourceChangeKey identifies a resource change within a plan. Deposed
+// objects share the address of the current object, so their key is included.
+type resourceChangeKey struct {
+	address string
+	deposed string
+}
+
+func resourceChangesByKey(p *PlanResourceChanges) map[resourceChangeKey]ResourceChange {
+	changes := make(map[resourceChangeKey]ResourceChange)
+	if p == nil {
+		return changes
+	}
+	for _, rc := range p.ResourceChanges {
+		changes[resourceChangeKey{address: rc.Address, deposed: rc.Deposed}] = rc
+	}
+	return changes
+}
+
+func sortResourceChanges(changes []ResourceChange) {
+	sort.Slice(changes, func(i, j int) bool {
+		return resourceChangeLess(changes[i], changes[j])
+	})
+}
+
+func resourceChangeLess(a, b ResourceChange) bool {
+	if a.Address != b.Address {
+		return a.Address < b.Address
+	}
+	return a.Deposed < b.Deposed
+}
+
+// moduleAddress returns the module path of a resource address, or an empty
+// string for resources in the root module.
+func moduleAddress(address string) string {
+	var steps []string
+	rest := address
+	for strings.HasPrefix(rest, "module.") {
+		end := len("module.")
+		inQuotes := false
+		depth := 0
+	scan:
+		for ; end < len(rest); end++ {
+			switch c := rest[end]; {
+			case c == '"' && rest[end-1] != '\\':
+				inQuotes = !inQuotes
+			case inQuotes:
+			case c == '[':
+				depth++
+			case c == ']':
+				depth--
+			case c == '.' && depth == 0:
+				break scan
+			}
+		}
+
+		steps = append(steps, rest[:end])
+		if end >= len(rest) {
+			break
+		}
+		rest = rest[end+1:]
+	}
+
+	return strings.Join(steps, ".")
+}
+
+// List the plans of the runs of a workspace, which is found by name.
+func (s *plans) List(ctx context.Context, options PlanListOptions) (*PlanList, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	w, err := s.client.Workspaces.Read(ctx, options.Organization, options.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	rl, err := s.client.Runs.List(ctx, w.ID, &RunListOptions{
+		ListOptions: options.ListOptions,
+		Include:     []RunIncludeOpt{RunPlan},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pl := &PlanList{Pagination: rl.Pagination, Items: []*Plan{}}
+	for _, r := range rl.Items {
+		if r.Plan == nil {
+			continue
+		}
+
+		p := r.Plan
+
+		// Only the ID is known when the plan wasn't included in the response.
+		if p.Status == "" {
+			p, err = s.Read(ctx, p.ID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		pl.Items = append(pl.Items, p)
+	}
+
+	return pl, nil
+}
+
+// newRequest creates a request for a plan endpoint. Unsuccessful responses
+// holding JSON:API errors are returned as an *ErrorsPayload, so the reason for
+// the error is not lost when it maps to a generic error.
+func (s *plans) newRequest(method, path string, v interface{}) (*ClientRequest, error) {
+	req, err := s.client.NewRequest(method, path, v)
+	if err != nil {
+		return nil, err
+	}
+	req.errorsPayload = true
+	return req, nil
+}
+
+// Read a plan by its ID.
+func (s *plans) Read(ctx context.Context, planID string) (*Plan, error) {
+	return s.ReadWithOptions(ctx, planID, PlanReadOptions{})
+}
+
+// ReadWithOptions reads a plan by its ID using the options supplied.
+func (s *plans) ReadWithOptions(ctx context.Context, planID string, options PlanReadOptions) (*Plan, error) {
+	if !validStringID(&planID) {
+		return nil, ErrInvalidPlanID
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("plans/%s", url.QueryEscape(planID))
+	req, err := s.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	// The cache is keyed by the URL of the request, which includes the
+	// relations to include.
+	key := req.retryableRequest.URL.String()
+
+	var cached *planCacheEntry
+	switch {
+	case options.IfNoneMatch != "":
+		req.Header.Set("If-None-Match", options.IfNoneMatch)
+	case s.cache != nil:
+		if cached = s.cache.get(key); cached != nil {
+			req.Header.Set("If-None-Match", cached.etag)
+		}
+	}
+
+	// Keep the raw response, so that the cache can decode a new plan from
+	// it every time it is used.
+	var etag string
+	body := &bytes.Buffer{}
+	err = req.Do(ContextWithETag(ctx, &etag), body)
+	switch {
+	case cached != nil && errors.Is(err, ErrNotModified):
+		body = bytes.NewBuffer(cached.body)
+	case err != nil:
+		return nil, err
+	case s.cache != nil && options.IfNoneMatch == "" && etag != "":
+		s.cache.add(key, etag, body.Bytes())
+	}
+
+	p := &Plan{}
+	if err := unmarshalResponse(body, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// planCache holds the last response to each plan read, keyed by its URL,
+// along with its ETag, up to a maximum number of plans. The least recently
+// used plan is evicted first. The cached responses are decoded into a new
+// plan every time they are used, so plans are never shared between reads.
+type planCache struct {
+	mu    sync.Mutex
+	size  int
+	order *list.List // Keys of the plans, most recently used first
+	plans map[string]*list.Element
+}
+
+type planCacheEntry struct {
+	key  string
+	etag string
+	body []byte
+}
+
+// newPlanCache returns a cache of up to size plans, or nil when size is not
+// positive.
+func newPlanCache(size int) *planCache {
+	if size <= 0 {
+		return nil
+	}
+	return &planCache{size: size, order: list.New(), plans: make(map[string]*list.Element)}
+}
+
+func (c *planCache) get(key string) *planCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.plans[key]
+	if !ok {
+		return nil
+	}
+	c.order.MoveToFront(e)
+
+	// The entries are replaced rather than updated, so they can be read
+	// without holding the lock.
+	return e.Value.(*planCacheEntry)
+}
+
+func (c *planCache) add(key, etag string, body []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &planCacheEntry{key: key, etag: etag, body: body}
+	if e, ok := c.plans[key]; ok {
+		e.Value = entry
+		c.order.MoveToFront(e)
+		return
+	}
+
+	c.plans[key] = c.order.PushFront(entry)
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.plans, oldest.Value.(*planCacheEntry).key)
+	}
+}
+
+// ReadForRun reads the plan of a run, included when reading the run. It returns
+// ErrRunHasNoPlan when the run has no plan yet.
+func (s *plans) ReadForRun(ctx context.Context, runID string) (*Plan, error) {
+	if !validStringID(&runID) {
+		return nil, ErrInvalidRunID
+	}
+
+	r, err := s.client.Runs.ReadWithOptions(ctx, runID, &RunReadOptions{
+		Include: []RunIncludeOpt{RunPlan},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Plan == nil {
+		return nil, ErrRunHasNoPlan
+	}
+
+	// Only the ID is known when the plan wasn't included in the response.
+	if r.Plan.Status == "" {
+		return s.Read(ctx, r.Plan.ID)
+	}
+
+	return r.Plan, nil
+}
+
+// ReadCostEstimate reads the cost estimate made for a plan, which the API
+// attaches to its run. It returns ErrCostEstimationDisabled when the run has
+// no cost estimate, as cost estimation is not enabled for every workspace.
+func (s *plans) ReadCostEstimate(ctx context.Context, planID string) (*CostEstimate, error) {
+	p, err := s.ReadWithOptions(ctx, planID, PlanReadOptions{
+		Include: []PlanIncludeOpt{PlanRunCostEstimate},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Run == nil {
+		return nil, ErrResourceNotFound
+	}
+	if p.Run.CostEstimate == nil {
+		return nil, ErrCostEstimationDisabled
+	}
+
+	// Only the ID is known when the cost estimate wasn't included in the
+	// response.
+	if p.Run.CostEstimate.Status == "" {
+		return s.client.CostEstimates.Read(ctx, p.Run.CostEstimate.ID)
+	}
+
+	return p.Run.CostEstimate, nil
+}
+
+// Logs retrieves the logs of a plan.
+func (s *plans) Logs(ctx context.Context, planID string) (io.Reader, error) {
+	return s.LogsWithOptions(ctx, planID, PlanLogOptions{})
+}
+
+// LogsWithOptions retrieves the logs of a plan. The returned reader polls for
+// new log output, and for the plan to finish, with a delay that backs off
+// from options.PollInterval to options.MaxBackoff while no output arrives.
+func (s *plans) LogsWithOptions(ctx context.Context, planID string, options PlanLogOptions) (io.Reader, error) {
+	if !validStringID(&planID) {
+		return nil, ErrInvalidPlanID
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	// Get the plan to make sure it exists.
+	p, err := s.Read(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return an error if the log URL is empty.
+	if p.LogReadURL == "" {
+		return nil, fmt.Errorf("plan %s does not have a log URL", planID)
+	}
+
+	u, err := url.Parse(p.LogReadURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid log URL: %w", err)
+	}
+
+	done := func() (bool, error) {
+		p, err := s.Read(ctx, p.ID)
+		if err != nil {
+			return false, err
+		}
+
+		switch p.Status {
+		case PlanCanceled, PlanErrored, PlanFinished, PlanUnreachable:
+			return true, nil
+		default:
+			return false, nil
+		}
+	}
+
+	return &LogReader{
+		client:  s.client,
+		ctx:     ctx,
+		done:    done,
+		logURL:  u,
+		backoff: options.backoff(),
+	}, nil
+}
+
+func (o PlanListOptions) valid() error {
+	if !validString(&o.Organization) {
+		return ErrRequiredOrg
+	}
+	if !validStringID(&o.Organization) {
+		return ErrInvalidOrg
+	}
+	if !validString(&o.Workspace) {
+		return ErrRequiredWorkspace
+	}
+	if !validStringID(&o.Workspace) {
+		return ErrInvalidWorkspaceValue
+	}
+	return nil
+}
+
+func (o PlanReadOptions) valid() error {
+	for _, include := range o.Include {
+		switch include {
+		case PlanRun, PlanRunWorkspace, PlanRunCostEstimate, PlanRunWorkspaceCurrentStateVersion:
+		default:
+			return ErrInvalidIncludeValue
+		}
+	}
+	return nil
+}
+
+func (o PlanLogOptions) valid() error {
+	if o.PollInterval < 0 || o.MaxBackoff < 0 {
+		return ErrInvalidPollInterval
+	}
+	return nil
+}
+
+// backoff returns the delays between polls for new log output.
+func (o PlanLogOptions) backoff() WaitBackoff {
+	pollInterval := o.PollInterval
+	if pollInterval == 0 {
+		pollInterval = 500 * time.Millisecond
+	}
+	maxBackoff := o.MaxBackoff
+	if maxBackoff == 0 {
+		maxBackoff = 2 * time.Second
+	}
+	if maxBackoff < pollInterval {
+		maxBackoff = pollInterval
+	}
+	return ExponentialWaitBackoff(pollInterval, maxBackoff)
+}
+
+// ReadDiagnostics reads the logs of a plan until the plan is done and returns
+// the diagnostics found in them, in the order they were logged. Only plans
+// that log in the structured JSON format, one JSON object per line, report
+// diagnostics; other log lines are skipped.
+func (s *plans) ReadDiagnostics(ctx context.Context, planID string) ([]Diagnostic, error) {
+	logs, err := s.Logs(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeDiagnostics(logs)
+}
+
+// Retrieve the JSON execution plan
+func (s *plans) ReadJSONOutput(ctx context.Context, planID string) ([]byte, error) {
+	if !validStringID(&planID) {
+		return nil, ErrInvalidPlanID
+	}
+
+	u := fmt.Sprintf("plans/%s/json-output", url.QueryEscape(planID))
+	req, err := s.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var status int
+	var buf bytes.Buffer
+	err = req.Do(contextWithStatusCode(ctx, &status), &buf)
+	if err != nil {
+		return nil, s.jsonOutputError(ctx, planID, status, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// jsonOutputError explains why the JSON execution plan of a plan could not be
+// read. Reading it requires admin access to the workspace, which the API
+// reports as forbidden. The API also responds with a 404 when the plan has not
+// finished yet, so the plan is read to tell it apart from a missing plan.
+func (s *plans) jsonOutputError(ctx context.Context, planID string, status int, err error) error {
+	if status == http.StatusForbidden {
+		return ErrPlanJSONUnauthorized
+	}
+	if !errors.Is(err, ErrResourceNotFound) {
+		return err
+	}
+
+	p, readErr := s.Read(ctx, planID)
+	if readErr != nil {
+		// The plan itself does not exist, or cannot be read either.
+		return err
+	}
+
+	switch p.Status {
+	case PlanFinished, PlanCanceled, PlanErrored, PlanUnreachable:
+		return err
+	default:
+		return ErrPlanJSONNotReady
+	}
+}
+
+// ReadJSONOutputStruct retrieves the JSON execution plan of a plan decoded
+// into a JSONOutput. Use ReadJSONOutput to get the raw JSON instead.
+func (s *plans) ReadJSONOutputStruct(ctx context.Context, planID string) (*JSONOutput, error) {
+	b, err := s.ReadJSONOutput(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &JSONOutput{}
+	if err := json.Unmarshal(b, out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// ReadResourceChanges fetch plan changed resources
+func (s *plans) ReadResourceChanges(ctx context.Context, planID string) (*PlanResourceChanges, error) {
+	if !validStringID(&planID) {
+		return nil, ErrInvalidPlanID
+	}
+
+	u := fmt.Sprintf("plans/%s/json-output-redacted", url.QueryEscape(planID))
+	req, err := s.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var status int
+	var buf bytes.Buffer
+	err = req.Do(contextWithStatusCode(ctx, &status), &buf)
+	if err != nil {
+		return nil, s.jsonOutputError(ctx, planID, status, err)
+	}
+
+	var resourceChanges PlanResourceChanges
+	if err := json.Unmarshal(buf.Bytes(), &resourceChanges); err != nil {
+		return nil, err
+	}
+
+	return &resourceChanges, nil
+}
+
+// ReadOutputChanges reads the output changes of the redacted JSON plan. The
+// values of sensitive outputs are redacted, their Change has BeforeSensitive
+// or AfterSensitive set to true instead. An empty map is returned when the
+// plan doesn't change any outputs.
+func (s *plans) ReadOutputChanges(ctx context.Context, planID string) (map[string]Change, error) {
+	if !validStringID(&planID) {
+		return nil, ErrInvalidPlanID
+	}
+
+	u := fmt.Sprintf("plans/%s/json-output-redacted", url.QueryEscape(planID))
+	req, err := s.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var status int
+	var buf bytes.Buffer
+	err = req.Do(contextWithStatusCode(ctx, &status), &buf)
+	if err != nil {
+		return nil, s.jsonOutputError(ctx, planID, status, err)
+	}
+
+	var out struct {
+		OutputChanges map[string]Change `json:"output_changes"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		return nil, err
+	}
+	if out.OutputChanges == nil {
+		out.OutputChanges = make(map[string]Change)
+	}
+
+	return out.OutputChanges, nil
+}
+
+// StreamResourceChanges decodes the resource changes of the redacted JSON plan
+// incrementally, so large plans are never held in memory as a whole. It stops
+// at the first error returned by fn and returns that error.
+func (s *plans) StreamResourceChanges(ctx context.Context, planID string, fn func(ResourceChange) error) error {
+	if !validStringID(&planID) {
+		return ErrInvalidPlanID
+	}
+
+	u := fmt.Sprintf("plans/%s/json-output-redacted", url.QueryEscape(planID))
+	req, err := s.newRequest("GET", u, nil)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pr, pw := io.Pipe()
+	defer pr.Close()
+
+	go func() {
+		var status int
+		if err := req.Do(contextWithStatusCode(ctx, &status), pw); err != nil {
+			pw.CloseWithError(s.jsonOutputError(ctx, planID, status, err))
+			return
+		}
+		pw.Close()
+	}()
+
+	return decodeResourceChanges(pr, fn)
+}
+
+// WaitForStatus reads the plan until its status is one of statuses. The delay
+// between reads follows DefaultWaitBackoff, unless options sets a poll
+// interval or backoff. When the plan ends in a
+// terminal status that was not requested, ErrPlanStatusNotReached is returned.
+// The last plan read is returned along with any error, including when the
+// context is canceled or options.MaxWait is exceeded.
+func (s *plans) WaitForStatus(ctx context.Context, planID string, options *WaitOptions, statuses ...PlanStatus) (*Plan, error) {
+	if !validStringID(&planID) {
+		return nil, ErrInvalidPlanID
+	}
+
+	if len(statuses) == 0 {
+		statuses = []PlanStatus{PlanFinished}
+	}
+
+	var p *Plan
+	err := wait(ctx, withDefaultBackoff(options), func() (bool, error) {
+		current, err := s.Read(ctx, planID)
+		if err != nil {
+			return false, err
+		}
+		p = current
+
+		for _, status := range statuses {
+			if p.Status == status {
+				return true, nil
+			}
+		}
+
+		switch p.Status {
+		case PlanCanceled, PlanErrored, PlanFinished, PlanUnreachable:
+			return false, fmt.Errorf("%w: %s", ErrPlanStatusNotReached, p.Status)
+		default:
+			return false, nil
+		}
+	})
+
+	return p, err
+}
+
+// Cancel cancels a plan by canceling its run, as the API has no action to
+// cancel a plan on its own. Plans that are created, pending, queued, running
+// or waiting for MFA can be canceled. The run is force-canceled instead when
+// it is force-cancelable, which the API only allows some time after a normal
+// cancel. A plan waiting for MFA is not stopped by a normal cancel, so
+// ErrPlanCancelPending is returned until its run can be force-canceled.
+// Nothing is done for plans that are already canceled, errored, finished or
+// unreachable.
+func (s *plans) Cancel(ctx context.Context, planID string) error {
+	if !validStringID(&planID) {
+		return ErrInvalidPlanID
+	}
+
+	p, err := s.ReadWithOptions(ctx, planID, PlanReadOptions{
+		Include: []PlanIncludeOpt{PlanRun},
+	})
+	if err != nil {
+		return err
+	}
+
+	switch p.Status {
+	case PlanCanceled, PlanErrored, PlanFinished, PlanUnreachable:
+		return nil
+	}
+
+	if p.Run == nil {
+		return ErrResourceNotFound
+	}
+
+	if p.Run.Actions != nil && p.Run.Actions.IsForceCancelable {
+		return s.client.Runs.ForceCancel(ctx, p.Run.ID, RunForceCancelOptions{})
+	}
+
+	// The run of a plan waiting for MFA is not cancelable again once it was
+	// canceled, until it becomes force-cancelable.
+	if p.Status != PlanMFAWaiting || p.Run.Actions == nil || p.Run.Actions.IsCancelable {
+		if err := s.client.Runs.Cancel(ctx, p.Run.ID, RunCancelOptions{}); err != nil {
+			return err
+		}
+	}
+
+	if p.Status == PlanMFAWaiting {
+		return ErrPlanCancelPending
+	}
+
+	return nil
+}
+
+// ReadExport finds the export of a plan with the given data type, waits for it
+// to finish when options.Wait is set, and writes its data, a .tar.gz archive,
+// to w. A finished export is preferred when the plan has several exports of
+// the data type. ErrPlanExportTypeNotFound is returned when the plan has no
+// export of the data type.
+func (s *plans) ReadExport(ctx context.Context, planID string, w io.Writer, options PlanReadExportOptions) (*PlanExport, error) {
+	p, err := s.Read(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	dataType := PlanExportSentinelMockBundleV0
+	if options.DataType != nil {
+		dataType = *options.DataType
+	}
+
+	var pe *PlanExport
+	for _, export := range p.Exports {
+		export, err = s.client.PlanExports.Read(ctx, export.ID)
+		if err != nil {
+			return nil, err
+		}
+		if export.DataType != dataType {
+			continue
+		}
+		if pe == nil || export.Status == PlanExportFinished {
+			pe = export
+		}
+		if export.Status == PlanExportFinished {
+			break
+		}
+	}
+	if pe == nil {
+		return nil, ErrPlanExportTypeNotFound
+	}
+
+	done, err := pe.done()
+	if err != nil {
+		return nil, err
+	}
+	if !done {
+		if options.Wait == nil {
+			return nil, ErrPlanExportNotReady
+		}
+
+		err = wait(ctx, options.Wait, func() (bool, error) {
+			pe, err = s.client.PlanExports.Read(ctx, pe.ID)
+			if err != nil {
+				return false, err
+			}
+			return pe.done()
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	u := fmt.Sprintf("plan-exports/%s/download", url.QueryEscape(pe.ID))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = req.Do(ctx, w)
+	if err != nil {
+		return nil, err
+	}
+
+	return pe, nil
+}
+
+// decodeDiagnostics reads structured log lines from r and returns the
+// diagnostic of every line of the "diagnostic" type. Lines that are not JSON
+// objects, such as the header TFE writes before the Terraform output, are
+// skipped.
+func decodeDiagnostics(r io.Reader) ([]Diagnostic, error) {
+	diagnostics := []Diagnostic{}
+
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 {
+			var entry struct {
+				Type       string      `json:"type"`
+				Diagnostic *Diagnostic `json:"diagnostic"`
+			}
+			line = bytes.TrimSpace(line)
+			if bytes.HasPrefix(line, []byte("{")) && json.Unmarshal(line, &entry) == nil &&
+				entry.Type == "diagnostic" && entry.Diagnostic != nil {
+				diagnostics = append(diagnostics, *entry.Diagnostic)
+			}
+		}
+		if err == io.EOF {
+			return diagnostics, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// decodeResourceChanges reads a JSON plan from r and calls fn for every
+// element of its resource_changes array. Every other field is skipped
+// without being decoded.
+func decodeResourceChanges(r io.Reader, fn func(ResourceChange) error) error {
+	dec := json.NewDecoder(r)
+
+	if err := expectJSONDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		if key != "resource_changes" {
+			if err := skipJSONValue(dec); err != nil {
+				return err
+			}
+			continue
+		}
+
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if tok == nil {
+			continue
+		}
+		if tok != json.Delim('[') {
+			return fmt.Errorf("unexpected %v in resource_changes", tok)
+		}
+
+		for dec.More() {
+			var rc ResourceChange
+			if err := dec.Decode(&rc); err != nil {
+				return err
+			}
+			if err := fn(rc); err != nil {
+				return err
+			}
+		}
+
+		// Consume the closing bracket of the array.
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func expectJSONDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != delim {
+		return fmt.Errorf("expected %v, got %v", delim, tok)
+	}
+
+	return nil
+}
+
+// skipJSONValue skips the next value of dec, including any nested values.
+func skipJSONValue(dec *json.Decoder) error {
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+
+		if depth == 0 {
+			return nil
+		}
+	}
 }
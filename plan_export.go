@@ -30,6 +30,10 @@ type PlanExports interface {
 
 	// Download the data of an plan export.
 	Download(ctx context.Context, planExportID string) ([]byte, error)
+
+	// ReadDownloadURL returns the temporary signed URL of a plan export's
+	// data without downloading it.
+	ReadDownloadURL(ctx context.Context, planExportID string) (string, error)
 }
 
 // planExports implements PlanExports.
@@ -167,6 +171,36 @@ func (s *planExports) Download(ctx context.Context, planExportID string) ([]byte
 	return buf.Bytes(), nil
 }
 
+// ReadDownloadURL returns the temporary signed URL the download endpoint of a
+// plan export redirects to, so the data can be fetched separately, for
+// example through a proxy. ErrNoRedirect is returned when the API serves the
+// data directly instead.
+func (s *planExports) ReadDownloadURL(ctx context.Context, planExportID string) (string, error) {
+	if !validStringID(&planExportID) {
+		return "", ErrInvalidPlanExportID
+	}
+
+	u := fmt.Sprintf("plan-exports/%s/download", url.QueryEscape(planExportID))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return req.doRedirect(ctx)
+}
+
+// done reports whether the plan export has finished, returning an error when
+// it stopped without finishing.
+func (pe *PlanExport) done() (bool, error) {
+	switch pe.Status {
+	case PlanExportFinished:
+		return true, nil
+	case PlanExportCanceled, PlanExportErrored, PlanExportExpired:
+		return false, fmt.Errorf("%w: %s", ErrPlanExportNotFinished, pe.Status)
+	}
+	return false, nil
+}
+
 func (o PlanExportCreateOptions) valid() error {
 	if o.Plan == nil {
 		return ErrRequiredPlan
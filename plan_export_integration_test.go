@@ -7,6 +7,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -125,6 +127,82 @@ func TestPlanExportsDownload(t *testing.T) {
 	})
 }
 
+func TestPlanExportsReadDownloadURL(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	peTest, peCleanup := createPlanExport(t, client, nil)
+	defer peCleanup()
+
+	t.Run("with a valid ID", func(t *testing.T) {
+		u, err := client.PlanExports.ReadDownloadURL(ctx, peTest.ID)
+		require.NoError(t, err)
+		assert.NotEmpty(t, u)
+		assert.NotContains(t, u, "plan-exports/"+peTest.ID+"/download")
+	})
+
+	t.Run("without a valid ID", func(t *testing.T) {
+		u, err := client.PlanExports.ReadDownloadURL(ctx, badIdentifier)
+		assert.Empty(t, u)
+		assert.Equal(t, err, ErrInvalidPlanExportID)
+	})
+}
+
+func TestPlanExportsReadDownloadURL_Redirect(t *testing.T) {
+	ctx := context.Background()
+
+	var downloads int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(204)
+		case "/api/v2/plan-exports/pe-redirect/download":
+			http.Redirect(w, r, "/archivist/signed?token=abc", http.StatusFound)
+		case "/api/v2/plan-exports/pe-direct/download":
+			w.Write([]byte("data"))
+		case "/archivist/signed":
+			downloads++
+			w.Write([]byte("data"))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "abcd1234",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	t.Run("with a redirect", func(t *testing.T) {
+		u, err := client.PlanExports.ReadDownloadURL(ctx, "pe-redirect")
+		require.NoError(t, err)
+		assert.Equal(t, ts.URL+"/archivist/signed?token=abc", u)
+		assert.Zero(t, downloads)
+	})
+
+	t.Run("without a redirect", func(t *testing.T) {
+		u, err := client.PlanExports.ReadDownloadURL(ctx, "pe-direct")
+		assert.Empty(t, u)
+		assert.Equal(t, ErrNoRedirect, err)
+	})
+
+	t.Run("when the plan export does not exist", func(t *testing.T) {
+		u, err := client.PlanExports.ReadDownloadURL(ctx, "pe-missing")
+		assert.Empty(t, u)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("downloads still follow redirects", func(t *testing.T) {
+		data, err := client.PlanExports.Download(ctx, "pe-redirect")
+		require.NoError(t, err)
+		assert.Equal(t, "data", string(data))
+		assert.Equal(t, 1, downloads)
+	})
+}
+
 func TestPlanExport_Unmarshal(t *testing.T) {
 	data := map[string]interface{}{
 		"data": map[string]interface{}{
@@ -7,7 +7,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,6 +22,93 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestPlansList(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/my-org/workspaces/my-workspace":
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		case "/api/v2/workspaces/ws-123/runs":
+			assert.Equal(t, "plan", r.URL.Query().Get("include"))
+			assert.Equal(t, "2", r.URL.Query().Get("page[number]"))
+			assert.Equal(t, "3", r.URL.Query().Get("page[size]"))
+			fmt.Fprint(w, `{
+				"data": [
+					{"id":"run-1","type":"runs","attributes":{"status":"applied"},"relationships":{"plan":{"data":{"id":"plan-1","type":"plans"}}}},
+					{"id":"run-2","type":"runs","attributes":{"status":"pending"},"relationships":{"plan":{"data":null}}},
+					{"id":"run-3","type":"runs","attributes":{"status":"planned"},"relationships":{"plan":{"data":{"id":"plan-3","type":"plans"}}}}
+				],
+				"included": [
+					{"id":"plan-1","type":"plans","attributes":{"status":"finished","resource-destructions":2}}
+				],
+				"meta": {"pagination":{"current-page":2,"prev-page":1,"next-page":3,"total-pages":3,"total-count":7}}
+			}`)
+		case "/api/v2/plans/plan-3":
+			fmt.Fprint(w, `{"data":{"id":"plan-3","type":"plans","attributes":{"status":"finished","resource-additions":1}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("with a workspace name", func(t *testing.T) {
+		pl, err := client.Plans.List(ctx, PlanListOptions{
+			ListOptions:  ListOptions{PageNumber: 2, PageSize: 3},
+			Organization: "my-org",
+			Workspace:    "my-workspace",
+		})
+		require.NoError(t, err)
+		require.Len(t, pl.Items, 2)
+
+		assert.Equal(t, "plan-1", pl.Items[0].ID)
+		assert.Equal(t, 2, pl.Items[0].ResourceDestructions)
+		assert.Equal(t, "plan-3", pl.Items[1].ID)
+		assert.Equal(t, PlanFinished, pl.Items[1].Status)
+		assert.Equal(t, 1, pl.Items[1].ResourceAdditions)
+
+		require.NotNil(t, pl.Pagination)
+		assert.Equal(t, 2, pl.CurrentPage)
+		assert.Equal(t, 3, pl.NextPage)
+		assert.Equal(t, 7, pl.TotalCount)
+	})
+
+	t.Run("when the workspace does not exist", func(t *testing.T) {
+		pl, err := client.Plans.List(ctx, PlanListOptions{Organization: "my-org", Workspace: "nonexisting"})
+		assert.Nil(t, pl)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without an organization", func(t *testing.T) {
+		pl, err := client.Plans.List(ctx, PlanListOptions{Workspace: "my-workspace"})
+		assert.Nil(t, pl)
+		assert.Equal(t, ErrRequiredOrg, err)
+	})
+
+	t.Run("with an invalid organization", func(t *testing.T) {
+		pl, err := client.Plans.List(ctx, PlanListOptions{Organization: badIdentifier, Workspace: "my-workspace"})
+		assert.Nil(t, pl)
+		assert.Equal(t, ErrInvalidOrg, err)
+	})
+
+	t.Run("without a workspace", func(t *testing.T) {
+		pl, err := client.Plans.List(ctx, PlanListOptions{Organization: "my-org"})
+		assert.Nil(t, pl)
+		assert.Equal(t, ErrRequiredWorkspace, err)
+	})
+
+	t.Run("with an invalid workspace", func(t *testing.T) {
+		pl, err := client.Plans.List(ctx, PlanListOptions{Organization: "my-org", Workspace: badIdentifier})
+		assert.Nil(t, pl)
+		assert.Equal(t, ErrInvalidWorkspaceValue, err)
+	})
+}
+
 func TestPlansRead(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -35,7 +129,7 @@ func TestPlansRead(t *testing.T) {
 	t.Run("when the plan does not exist", func(t *testing.T) {
 		p, err := client.Plans.Read(ctx, "nonexisting")
 		assert.Nil(t, p)
-		assert.Equal(t, err, ErrResourceNotFound)
+		assert.ErrorIs(t, err, ErrResourceNotFound)
 	})
 
 	t.Run("with invalid plan ID", func(t *testing.T) {
@@ -45,6 +139,529 @@ func TestPlansRead(t *testing.T) {
 	})
 }
 
+func TestPlansReadForRun(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	rTest, rTestCleanup := createPlannedRun(t, client, nil)
+	defer rTestCleanup()
+
+	t.Run("when the run has a plan", func(t *testing.T) {
+		p, err := client.Plans.ReadForRun(ctx, rTest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, rTest.Plan.ID, p.ID)
+		assert.Equal(t, PlanFinished, p.Status)
+	})
+
+	t.Run("when the run does not exist", func(t *testing.T) {
+		p, err := client.Plans.ReadForRun(ctx, "nonexisting")
+		assert.Nil(t, p)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with invalid run ID", func(t *testing.T) {
+		p, err := client.Plans.ReadForRun(ctx, badIdentifier)
+		assert.Nil(t, p)
+		assert.Equal(t, ErrInvalidRunID, err)
+	})
+}
+
+func TestPlansReadForRun_Include(t *testing.T) {
+	ctx := context.Background()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/runs/run-included":
+			assert.Equal(t, "plan", r.URL.Query().Get("include"))
+			fmt.Fprint(w, `{
+				"data": {"id": "run-included", "type": "runs", "attributes": {"status": "planned"},
+					"relationships": {"plan": {"data": {"id": "plan-1", "type": "plans"}}}},
+				"included": [{"id": "plan-1", "type": "plans", "attributes": {"status": "finished", "has-changes": true}}]
+			}`)
+		case "/api/v2/runs/run-reference":
+			fmt.Fprint(w, `{"data": {"id": "run-reference", "type": "runs", "attributes": {"status": "planning"},
+				"relationships": {"plan": {"data": {"id": "plan-2", "type": "plans"}}}}}`)
+		case "/api/v2/plans/plan-2":
+			fmt.Fprint(w, `{"data": {"id": "plan-2", "type": "plans", "attributes": {"status": "running"}}}`)
+		case "/api/v2/runs/run-pending":
+			fmt.Fprint(w, `{"data": {"id": "run-pending", "type": "runs", "attributes": {"status": "pending"},
+				"relationships": {"plan": {"data": null}}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+
+	t.Run("when the plan is included", func(t *testing.T) {
+		p, err := client.Plans.ReadForRun(ctx, "run-included")
+		require.NoError(t, err)
+		assert.Equal(t, "plan-1", p.ID)
+		assert.Equal(t, PlanFinished, p.Status)
+		assert.True(t, p.HasChanges)
+	})
+
+	t.Run("when only the plan ID is returned", func(t *testing.T) {
+		p, err := client.Plans.ReadForRun(ctx, "run-reference")
+		require.NoError(t, err)
+		assert.Equal(t, "plan-2", p.ID)
+		assert.Equal(t, PlanRunning, p.Status)
+	})
+
+	t.Run("when the run has no plan yet", func(t *testing.T) {
+		p, err := client.Plans.ReadForRun(ctx, "run-pending")
+		assert.Nil(t, p)
+		assert.Equal(t, ErrRunHasNoPlan, err)
+	})
+}
+
+func TestPlansReadCostEstimate(t *testing.T) {
+	skipIfEnterprise(t)
+
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	orgTest, err := client.Organizations.Update(ctx, orgTest.Name, OrganizationUpdateOptions{
+		CostEstimationEnabled: Bool(true),
+	})
+	require.NoError(t, err)
+
+	wTest, wTestCleanup := createWorkspace(t, client, orgTest)
+	defer wTestCleanup()
+	rTest, rTestCleanup := createCostEstimatedRun(t, client, wTest)
+	defer rTestCleanup()
+
+	t.Run("when the run was cost estimated", func(t *testing.T) {
+		ce, err := client.Plans.ReadCostEstimate(ctx, rTest.Plan.ID)
+		require.NoError(t, err)
+		assert.Equal(t, rTest.CostEstimate.ID, ce.ID)
+		assert.NotEmpty(t, ce.Status)
+	})
+
+	t.Run("with invalid plan ID", func(t *testing.T) {
+		ce, err := client.Plans.ReadCostEstimate(ctx, badIdentifier)
+		assert.Nil(t, ce)
+		assert.EqualError(t, err, ErrInvalidPlanID.Error())
+	})
+}
+
+func TestPlansReadCostEstimate_Include(t *testing.T) {
+	ctx := context.Background()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/plans/plan-included":
+			assert.Equal(t, "run.cost_estimate", r.URL.Query().Get("include"))
+			fmt.Fprint(w, `{
+				"data": {"id": "plan-included", "type": "plans", "attributes": {"status": "finished"},
+					"relationships": {"run": {"data": {"id": "run-1", "type": "runs"}}}},
+				"included": [
+					{"id": "run-1", "type": "runs", "attributes": {"status": "cost_estimated"},
+						"relationships": {"cost-estimate": {"data": {"id": "ce-1", "type": "cost-estimates"}}}},
+					{"id": "ce-1", "type": "cost-estimates", "attributes": {"status": "finished",
+						"proposed-monthly-cost": "12.5", "prior-monthly-cost": "10.25", "delta-monthly-cost": "2.25",
+						"resources-count": 3, "matched-resources-count": 2, "unmatched-resources-count": 1}}
+				]
+			}`)
+		case "/api/v2/plans/plan-reference":
+			fmt.Fprint(w, `{
+				"data": {"id": "plan-reference", "type": "plans", "attributes": {"status": "finished"},
+					"relationships": {"run": {"data": {"id": "run-2", "type": "runs"}}}},
+				"included": [
+					{"id": "run-2", "type": "runs", "attributes": {"status": "cost_estimated"},
+						"relationships": {"cost-estimate": {"data": {"id": "ce-2", "type": "cost-estimates"}}}}
+				]
+			}`)
+		case "/api/v2/cost-estimates/ce-2":
+			fmt.Fprint(w, `{"data": {"id": "ce-2", "type": "cost-estimates", "attributes": {"status": "pending"}}}`)
+		case "/api/v2/plans/plan-disabled":
+			fmt.Fprint(w, `{
+				"data": {"id": "plan-disabled", "type": "plans", "attributes": {"status": "finished"},
+					"relationships": {"run": {"data": {"id": "run-3", "type": "runs"}}}},
+				"included": [
+					{"id": "run-3", "type": "runs", "attributes": {"status": "planned"},
+						"relationships": {"cost-estimate": {"data": null}}}
+				]
+			}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+
+	t.Run("when the cost estimate is included", func(t *testing.T) {
+		ce, err := client.Plans.ReadCostEstimate(ctx, "plan-included")
+		require.NoError(t, err)
+		assert.Equal(t, "ce-1", ce.ID)
+		assert.Equal(t, CostEstimateFinished, ce.Status)
+		assert.Equal(t, 3, ce.ResourcesCount)
+		assert.Equal(t, 2, ce.MatchedResourcesCount)
+		assert.Equal(t, 1, ce.UnmatchedResourcesCount)
+
+		costs, err := ce.MonthlyCosts()
+		require.NoError(t, err)
+		assert.Equal(t, CostEstimateMonthlyCosts{Proposed: 12.5, Prior: 10.25, Delta: 2.25}, costs)
+	})
+
+	t.Run("when only the cost estimate ID is returned", func(t *testing.T) {
+		ce, err := client.Plans.ReadCostEstimate(ctx, "plan-reference")
+		require.NoError(t, err)
+		assert.Equal(t, "ce-2", ce.ID)
+		assert.Equal(t, CostEstimatePending, ce.Status)
+	})
+
+	t.Run("when cost estimation is disabled", func(t *testing.T) {
+		ce, err := client.Plans.ReadCostEstimate(ctx, "plan-disabled")
+		assert.Nil(t, ce)
+		assert.Equal(t, ErrCostEstimationDisabled, err)
+	})
+}
+
+func TestPlansWaitForStatus(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	rTest, rTestCleanup := createPlannedRun(t, client, nil)
+	defer rTestCleanup()
+
+	t.Run("when the plan is finished", func(t *testing.T) {
+		p, err := client.Plans.WaitForStatus(ctx, rTest.Plan.ID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, PlanFinished, p.Status)
+	})
+
+	t.Run("with invalid plan ID", func(t *testing.T) {
+		p, err := client.Plans.WaitForStatus(ctx, badIdentifier, nil)
+		assert.Nil(t, p)
+		assert.Equal(t, ErrInvalidPlanID, err)
+	})
+}
+
+func TestPlansWaitForStatus_Polling(t *testing.T) {
+	ctx := context.Background()
+
+	var statuses []PlanStatus
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		status := statuses[0]
+		if len(statuses) > 1 {
+			statuses = statuses[1:]
+		}
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		fmt.Fprintf(w, `{"data":{"id":"plan-123","type":"plans","attributes":{"status":%q}}}`, status)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+
+	options := &WaitOptions{PollInterval: time.Millisecond}
+
+	t.Run("when the plan reaches a requested status", func(t *testing.T) {
+		statuses = []PlanStatus{PlanQueued, PlanRunning, PlanFinished}
+		p, err := client.Plans.WaitForStatus(ctx, "plan-123", options, PlanFinished)
+		require.NoError(t, err)
+		assert.Equal(t, PlanFinished, p.Status)
+	})
+
+	t.Run("when the plan ends in another status", func(t *testing.T) {
+		statuses = []PlanStatus{PlanRunning, PlanErrored}
+		p, err := client.Plans.WaitForStatus(ctx, "plan-123", options, PlanFinished)
+		assert.ErrorIs(t, err, ErrPlanStatusNotReached)
+		require.NotNil(t, p)
+		assert.Equal(t, PlanErrored, p.Status)
+	})
+
+	t.Run("with several requested statuses", func(t *testing.T) {
+		statuses = []PlanStatus{PlanRunning, PlanCanceled}
+		p, err := client.Plans.WaitForStatus(ctx, "plan-123", options, PlanFinished, PlanCanceled)
+		require.NoError(t, err)
+		assert.Equal(t, PlanCanceled, p.Status)
+	})
+
+	t.Run("when the maximum wait time is exceeded", func(t *testing.T) {
+		statuses = []PlanStatus{PlanRunning}
+		p, err := client.Plans.WaitForStatus(ctx, "plan-123", &WaitOptions{
+			PollInterval: time.Millisecond,
+			MaxWait:      20 * time.Millisecond,
+		})
+		assert.Equal(t, ErrWaitTimeout, err)
+		require.NotNil(t, p)
+		assert.Equal(t, PlanRunning, p.Status)
+	})
+}
+
+func TestPlansReadWithOptions(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	rTest, rTestCleanup := createPlannedRun(t, client, nil)
+	defer rTestCleanup()
+
+	t.Run("with the current state version of the workspace included", func(t *testing.T) {
+		p, err := client.Plans.ReadWithOptions(ctx, rTest.Plan.ID, PlanReadOptions{
+			Include: []PlanIncludeOpt{PlanRunWorkspaceCurrentStateVersion},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, p.Run)
+		require.NotNil(t, p.Run.Workspace)
+		assert.Equal(t, rTest.Workspace.ID, p.Run.Workspace.ID)
+	})
+
+	t.Run("with the run and workspace included", func(t *testing.T) {
+		p, err := client.Plans.ReadWithOptions(ctx, rTest.Plan.ID, PlanReadOptions{
+			Include: []PlanIncludeOpt{PlanRun, PlanRunWorkspace},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, p.Run)
+		assert.Equal(t, rTest.ID, p.Run.ID)
+		require.NotNil(t, p.Run.Workspace)
+		assert.Equal(t, rTest.Workspace.ID, p.Run.Workspace.ID)
+		assert.NotEmpty(t, p.Run.Workspace.Name)
+	})
+
+	t.Run("with an invalid include option", func(t *testing.T) {
+		_, err := client.Plans.ReadWithOptions(ctx, rTest.Plan.ID, PlanReadOptions{
+			Include: []PlanIncludeOpt{"workspace"},
+		})
+		assert.Equal(t, ErrInvalidIncludeValue, err)
+	})
+
+	t.Run("with invalid plan ID", func(t *testing.T) {
+		p, err := client.Plans.ReadWithOptions(ctx, badIdentifier, PlanReadOptions{})
+		assert.Nil(t, p)
+		assert.Equal(t, ErrInvalidPlanID, err)
+	})
+}
+
+func TestPlansReadWithOptions_Include(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		assert.Equal(t, "/api/v2/plans/plan-123", r.URL.Path)
+		assert.Equal(t, "run,run.workspace", r.URL.Query().Get("include"))
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		fmt.Fprint(w, `{
+			"data": {"id": "plan-123", "type": "plans", "attributes": {"status": "finished"},
+				"relationships": {"run": {"data": {"id": "run-123", "type": "runs"}}}},
+			"included": [
+				{"id": "run-123", "type": "runs", "attributes": {"status": "planned"},
+					"relationships": {"workspace": {"data": {"id": "ws-123", "type": "workspaces"}}}},
+				{"id": "ws-123", "type": "workspaces", "attributes": {"name": "my-workspace"}}
+			]
+		}`)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+
+	p, err := client.Plans.ReadWithOptions(context.Background(), "plan-123", PlanReadOptions{
+		Include: []PlanIncludeOpt{PlanRun, PlanRunWorkspace},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, p.Run)
+	assert.Equal(t, "run-123", p.Run.ID)
+	assert.Equal(t, RunPlanned, p.Run.Status)
+	require.NotNil(t, p.Run.Workspace)
+	assert.Equal(t, "my-workspace", p.Run.Workspace.Name)
+}
+
+func TestPlansReadWithOptions_CurrentStateVersion(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		assert.Equal(t, "run.workspace.current_state_version", r.URL.Query().Get("include"))
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		fmt.Fprint(w, `{
+			"data": {"id": "plan-123", "type": "plans", "attributes": {"status": "finished"},
+				"relationships": {"run": {"data": {"id": "run-123", "type": "runs"}}}},
+			"included": [
+				{"id": "run-123", "type": "runs", "attributes": {"status": "planned"},
+					"relationships": {"workspace": {"data": {"id": "ws-123", "type": "workspaces"}}}},
+				{"id": "ws-123", "type": "workspaces", "attributes": {"name": "my-workspace"},
+					"relationships": {"current-state-version": {"data": {"id": "sv-123", "type": "state-versions"}}}},
+				{"id": "sv-123", "type": "state-versions", "attributes": {"serial": 42}}
+			]
+		}`)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+
+	p, err := client.Plans.ReadWithOptions(context.Background(), "plan-123", PlanReadOptions{
+		Include: []PlanIncludeOpt{PlanRunWorkspaceCurrentStateVersion},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, p.Run)
+	require.NotNil(t, p.Run.Workspace)
+	require.NotNil(t, p.Run.Workspace.CurrentStateVersion)
+	assert.Equal(t, "sv-123", p.Run.Workspace.CurrentStateVersion.ID)
+	assert.Equal(t, int64(42), p.Run.Workspace.CurrentStateVersion.Serial)
+}
+
+func TestPlansReadWithOptions_IfNoneMatch(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		assert.Equal(t, "/api/v2/plans/plan-123", r.URL.Path)
+		assert.Empty(t, r.URL.Query().Get("IfNoneMatch"))
+		if r.Header.Get("If-None-Match") == `W/"abc"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		w.Header().Set("ETag", `W/"abc"`)
+		fmt.Fprint(w, `{"data":{"id":"plan-123","type":"plans","attributes":{"status":"finished"}}}`)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	var etag string
+	_, err = client.Plans.Read(ContextWithETag(ctx, &etag), "plan-123")
+	require.NoError(t, err)
+	assert.Equal(t, `W/"abc"`, etag)
+
+	t.Run("when the plan has not changed", func(t *testing.T) {
+		cached, err := client.Plans.ReadWithOptions(ctx, "plan-123", PlanReadOptions{IfNoneMatch: etag})
+		assert.Nil(t, cached)
+		assert.Equal(t, ErrNotModified, err)
+	})
+
+	t.Run("when the plan has changed", func(t *testing.T) {
+		var etag string
+		p, err := client.Plans.ReadWithOptions(ContextWithETag(ctx, &etag), "plan-123", PlanReadOptions{IfNoneMatch: `W/"old"`})
+		require.NoError(t, err)
+		assert.Equal(t, PlanFinished, p.Status)
+		assert.Equal(t, `W/"abc"`, etag)
+	})
+}
+
+func TestPlansRead_Cache(t *testing.T) {
+	var reads, notModified int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		planID := strings.TrimPrefix(r.URL.Path, "/api/v2/plans/")
+		etag := fmt.Sprintf(`W/"%s"`, planID)
+		reads++
+		w.Header().Set("ETag", etag)
+		if r.Header.Get("If-None-Match") == etag {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		fmt.Fprintf(w, `{"data":{"id":%q,"type":"plans","attributes":{"status":"finished","resource-additions":1,"status-timestamps":{"finished-at":"2023-01-02T03:04:05Z"}}}}`, planID)
+	}))
+	t.Cleanup(ts.Close)
+	ctx := context.Background()
+
+	t.Run("when the plan has not changed", func(t *testing.T) {
+		reads, notModified = 0, 0
+		client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client(), PlanCacheSize: 2})
+		require.NoError(t, err)
+
+		p, err := client.Plans.Read(ctx, "plan-1")
+		require.NoError(t, err)
+		p.ResourceAdditions = 10
+		p.StatusTimestamps.FinishedAt = time.Time{}
+
+		var etag string
+		cached, err := client.Plans.Read(ContextWithETag(ctx, &etag), "plan-1")
+		require.NoError(t, err)
+		assert.Equal(t, "plan-1", cached.ID)
+		assert.Equal(t, PlanFinished, cached.Status)
+		assert.Equal(t, 1, cached.ResourceAdditions)
+		assert.Equal(t, time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC), cached.StatusTimestamps.FinishedAt)
+		assert.Equal(t, `W/"plan-1"`, etag)
+		assert.Equal(t, 2, reads)
+		assert.Equal(t, 1, notModified)
+
+		// Every read of a cached plan returns a new plan.
+		again, err := client.Plans.Read(ctx, "plan-1")
+		require.NoError(t, err)
+		assert.NotSame(t, cached.StatusTimestamps, again.StatusTimestamps)
+	})
+
+	t.Run("when the cache is full", func(t *testing.T) {
+		reads, notModified = 0, 0
+		client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client(), PlanCacheSize: 2})
+		require.NoError(t, err)
+
+		for _, planID := range []string{"plan-1", "plan-2", "plan-1", "plan-3", "plan-2", "plan-3"} {
+			_, err := client.Plans.Read(ctx, planID)
+			require.NoError(t, err)
+		}
+
+		// plan-2 is evicted by plan-3, as plan-1 was read more recently, and
+		// then plan-1 is evicted by plan-2.
+		assert.Equal(t, 6, reads)
+		assert.Equal(t, 2, notModified)
+	})
+
+	t.Run("with relations to include", func(t *testing.T) {
+		reads, notModified = 0, 0
+		client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client(), PlanCacheSize: 2})
+		require.NoError(t, err)
+
+		_, err = client.Plans.Read(ctx, "plan-1")
+		require.NoError(t, err)
+		_, err = client.Plans.ReadWithOptions(ctx, "plan-1", PlanReadOptions{Include: []PlanIncludeOpt{PlanRun}})
+		require.NoError(t, err)
+		assert.Equal(t, 0, notModified)
+	})
+
+	t.Run("without a cache size", func(t *testing.T) {
+		reads, notModified = 0, 0
+		client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			_, err := client.Plans.Read(ctx, "plan-1")
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 2, reads)
+		assert.Equal(t, 0, notModified)
+	})
+}
+
 func TestPlansLogs(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -72,6 +689,56 @@ func TestPlansLogs(t *testing.T) {
 	})
 }
 
+func TestPlansLogsWithOptions(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	rTest, rTestCleanup := createPlannedRun(t, client, nil)
+	t.Cleanup(rTestCleanup)
+
+	t.Run("with a poll interval and maximum backoff", func(t *testing.T) {
+		logReader, err := client.Plans.LogsWithOptions(ctx, rTest.Plan.ID, PlanLogOptions{
+			PollInterval: time.Second,
+			MaxBackoff:   5 * time.Second,
+		})
+		require.NoError(t, err)
+
+		logs, err := io.ReadAll(logReader)
+		require.NoError(t, err)
+
+		assert.Contains(t, string(logs), "1 to add, 0 to change, 0 to destroy")
+	})
+
+	t.Run("with a negative poll interval", func(t *testing.T) {
+		logs, err := client.Plans.LogsWithOptions(ctx, rTest.Plan.ID, PlanLogOptions{
+			PollInterval: -time.Second,
+		})
+		assert.Nil(t, logs)
+		assert.Equal(t, ErrInvalidPollInterval, err)
+	})
+}
+
+func TestPlanLogOptions_backoff(t *testing.T) {
+	t.Run("with default options", func(t *testing.T) {
+		b := PlanLogOptions{}.backoff()
+		assert.Equal(t, 500*time.Millisecond, b(0))
+		assert.Equal(t, 2*time.Second, b(100))
+	})
+
+	t.Run("with a poll interval and maximum backoff", func(t *testing.T) {
+		b := PlanLogOptions{PollInterval: time.Second, MaxBackoff: 10 * time.Second}.backoff()
+		assert.Equal(t, time.Second, b(0))
+		assert.Equal(t, 2*time.Second, b(5))
+		assert.Equal(t, 10*time.Second, b(100))
+	})
+
+	t.Run("with a poll interval longer than the default maximum backoff", func(t *testing.T) {
+		b := PlanLogOptions{PollInterval: 5 * time.Second}.backoff()
+		assert.Equal(t, 5*time.Second, b(0))
+		assert.Equal(t, 5*time.Second, b(100))
+	})
+}
+
 func TestPlan_Unmarshal(t *testing.T) {
 	data := map[string]interface{}{
 		"data": map[string]interface{}{
@@ -116,6 +783,60 @@ func TestPlan_Unmarshal(t *testing.T) {
 	assert.Equal(t, plan.StatusTimestamps.ErroredAt, erroredParsedTime)
 }
 
+func TestPlan_IsNoOp(t *testing.T) {
+	assert.True(t, (&Plan{}).IsNoOp())
+	assert.False(t, (&Plan{HasChanges: true}).IsNoOp())
+	assert.False(t, (&Plan{ResourceAdditions: 1}).IsNoOp())
+	assert.False(t, (&Plan{ResourceChanges: 1}).IsNoOp())
+	assert.False(t, (&Plan{ResourceDestructions: 1}).IsNoOp())
+	assert.False(t, (&Plan{ResourceImports: 1}).IsNoOp())
+
+	t.Run("with only output changes", func(t *testing.T) {
+		p := &Plan{HasChanges: true}
+		assert.False(t, p.IsNoOp())
+		assert.True(t, p.HasOnlyOutputChanges())
+
+		assert.False(t, (&Plan{}).HasOnlyOutputChanges())
+		assert.False(t, (&Plan{HasChanges: true, ResourceAdditions: 1}).HasOnlyOutputChanges())
+	})
+}
+
+func TestJSONOutput_IsNoOp(t *testing.T) {
+	noOp := Change{Actions: []string{"no-op"}}
+	update := Change{Actions: []string{"update"}}
+
+	assert.True(t, (&JSONOutput{}).IsNoOp())
+	assert.True(t, (&JSONOutput{
+		PlanResourceChanges: PlanResourceChanges{ResourceChanges: []ResourceChange{
+			{Address: "aws_instance.web", Change: noOp},
+			{Address: "data.aws_ami.ubuntu", Change: Change{Actions: []string{"read"}}},
+		}},
+		OutputChanges: map[string]Change{"ip": noOp},
+	}).IsNoOp())
+
+	t.Run("with resource changes", func(t *testing.T) {
+		assert.False(t, (&JSONOutput{
+			PlanResourceChanges: PlanResourceChanges{ResourceChanges: []ResourceChange{{Address: "aws_instance.web", Change: update}}},
+		}).IsNoOp())
+	})
+
+	t.Run("with only output changes", func(t *testing.T) {
+		assert.False(t, (&JSONOutput{
+			PlanResourceChanges: PlanResourceChanges{ResourceChanges: []ResourceChange{{Address: "aws_instance.web", Change: noOp}}},
+			OutputChanges:       map[string]Change{"ip": update},
+		}).IsNoOp())
+	})
+
+	t.Run("with an import", func(t *testing.T) {
+		assert.False(t, (&JSONOutput{
+			PlanResourceChanges: PlanResourceChanges{ResourceChanges: []ResourceChange{{
+				Address: "aws_instance.web",
+				Change:  Change{Actions: []string{"no-op"}, Importing: &ChangeImporting{ID: "i-123"}},
+			}}},
+		}).IsNoOp())
+	})
+}
+
 func TestPlansJSONOutput(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -137,23 +858,1190 @@ func TestPlansJSONOutput(t *testing.T) {
 		assert.Nil(t, d)
 		assert.Error(t, err)
 	})
+
+	t.Run("when decoding the JSON output", func(t *testing.T) {
+		out, err := client.Plans.ReadJSONOutputStruct(ctx, rTest.Plan.ID)
+		require.NoError(t, err)
+		assert.NotEmpty(t, out.FormatVersion)
+		assert.NotEmpty(t, out.TerraformVersion)
+		require.NotNil(t, out.PlannedValues)
+		assert.NotEmpty(t, out.ResourceChanges)
+		assert.NotNil(t, out.Configuration)
+	})
+
+	t.Run("when decoding with an invalid plan ID", func(t *testing.T) {
+		out, err := client.Plans.ReadJSONOutputStruct(ctx, badIdentifier)
+		assert.Nil(t, out)
+		assert.Equal(t, ErrInvalidPlanID, err)
+	})
 }
 
-func TestPlansReadResourceChanges(t *testing.T) {
-	client := testClient(t)
-	ctx := context.Background()
-	rTest, rTestCleanup := createPlannedRun(t, client, nil)
-	defer rTestCleanup()
+func TestJSONOutput_RoundTrip(t *testing.T) {
+	for _, fixture := range []string{"format-1.0.json", "format-1.1.json", "prior-state.json", "import.json", "checks.json"} {
+		t.Run(fixture, func(t *testing.T) {
+			data, err := os.ReadFile("test-fixtures/json-plan/" + fixture)
+			require.NoError(t, err)
 
-	t.Run("when resource changes exist for the plan", func(t *testing.T) {
-		resourceChanges, err := client.Plans.ReadResourceChanges(ctx, rTest.Plan.ID)
+			var out JSONOutput
+			require.NoError(t, json.Unmarshal(data, &out))
+
+			encoded, err := json.Marshal(out)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(data), string(encoded))
+		})
+	}
+
+	t.Run("with format version 1.0", func(t *testing.T) {
+		data, err := os.ReadFile("test-fixtures/json-plan/format-1.0.json")
 		require.NoError(t, err)
-		assert.NotEmpty(t, resourceChanges.ResourceChanges)
+
+		var out JSONOutput
+		require.NoError(t, json.Unmarshal(data, &out))
+
+		assert.Equal(t, "1.0", out.FormatVersion)
+		assert.Equal(t, "1.1.9", out.TerraformVersion)
+		assert.JSONEq(t, `"demo"`, string(out.Variables["prefix"].Value))
+
+		require.NotNil(t, out.PlannedValues)
+		require.Len(t, out.PlannedValues.RootModule.ChildModules, 1)
+		pet := out.PlannedValues.RootModule.ChildModules[0]
+		assert.Equal(t, "module.pet", pet.Address)
+		require.Len(t, pet.Resources, 1)
+		var values map[string]interface{}
+		require.NoError(t, json.Unmarshal(pet.Resources[0].Values, &values))
+		assert.Equal(t, float64(2), values["length"])
+
+		require.Len(t, out.ResourceChanges, 2)
+		assert.Equal(t, float64(0), out.ResourceChanges[0].Index)
+		assert.Equal(t, "module.pet", out.ResourceChanges[1].ModuleAddress)
+		assert.Equal(t, true, out.OutputChanges["id"].AfterUnknown)
+		assert.Contains(t, out.Configuration.RootModule.ModuleCalls, "pet")
 	})
 
-	t.Run("when the plan does not exist", func(t *testing.T) {
-		resourceChanges, err := client.Plans.ReadResourceChanges(ctx, "nonexisting")
-		assert.Nil(t, resourceChanges)
+	t.Run("with a prior state and nested modules", func(t *testing.T) {
+		data, err := os.ReadFile("test-fixtures/json-plan/prior-state.json")
+		require.NoError(t, err)
+
+		var out JSONOutput
+		require.NoError(t, json.Unmarshal(data, &out))
+
+		require.NotNil(t, out.PriorState)
+		assert.Equal(t, "1.0", out.PriorState.FormatVersion)
+		assert.Equal(t, "1.5.7", out.PriorState.TerraformVersion)
+		require.NotNil(t, out.PriorState.Values)
+		assert.JSONEq(t, `"vpc-0a1b2c3d"`, string(out.PriorState.Values.Outputs["vpc_id"].Value))
+
+		prior := out.PriorState.Values.RootModule.AllResources()
+		require.Len(t, prior, 2)
+		assert.Equal(t, "module.network.aws_vpc.main", prior[0].Address)
+		var values map[string]interface{}
+		require.NoError(t, json.Unmarshal(prior[0].Values, &values))
+		assert.Equal(t, map[string]interface{}{"Name": "legacy"}, values["tags"])
+		assert.Equal(t, "module.network.module.subnets.aws_subnet.this[0]", prior[1].Address)
+		assert.Equal(t, []string{"module.network.aws_vpc.main"}, prior[1].DependsOn)
+
+		require.NotNil(t, out.PlannedValues)
+		network := out.PlannedValues.RootModule.ChildModules[0]
+		require.Len(t, network.ChildModules, 1)
+		assert.Equal(t, "module.network.module.subnets", network.ChildModules[0].Address)
+
+		planned := out.PlannedValues.RootModule.AllResources()
+		require.Len(t, planned, 2)
+		values = nil
+		require.NoError(t, json.Unmarshal(planned[0].Values, &values))
+		assert.Equal(t, map[string]interface{}{"Name": "main"}, values["tags"])
+		assert.Equal(t, float64(0), planned[1].Index)
+	})
+
+	t.Run("with format version 1.1", func(t *testing.T) {
+		data, err := os.ReadFile("test-fixtures/json-plan/format-1.1.json")
+		require.NoError(t, err)
+
+		var out JSONOutput
+		require.NoError(t, json.Unmarshal(data, &out))
+
+		assert.Equal(t, "1.1", out.FormatVersion)
+		assert.Equal(t, "1.3.9", out.TerraformVersion)
+
+		token := out.PlannedValues.Outputs["token"]
+		assert.True(t, token.Sensitive)
+		assert.JSONEq(t, `"string"`, string(token.Type))
+		assert.Nil(t, out.PlannedValues.Outputs["ids"].Value)
+
+		require.Len(t, out.ResourceChanges, 3)
+		assert.Equal(t, ChangeActionReasonReadBecauseConfigUnknown, out.ResourceChanges[0].ActionReason)
+		assert.Equal(t, "a", out.ResourceChanges[1].Index)
+		assert.Equal(t, []ResourceAttr{
+			{Resource: `null_resource.each["a"]`, Attribute: []interface{}{"id"}},
+		}, out.RelevantAttributes)
+	})
+}
+
+func TestJSONOutput_Checks(t *testing.T) {
+	data, err := os.ReadFile("test-fixtures/json-plan/checks.json")
+	require.NoError(t, err)
+
+	var out JSONOutput
+	require.NoError(t, json.Unmarshal(data, &out))
+	require.Len(t, out.Checks, 4)
+
+	t.Run("checks are decoded", func(t *testing.T) {
+		web := out.Checks[0]
+		assert.Equal(t, "resource", web.Address.Kind)
+		assert.Equal(t, "aws_instance.web", web.Address.ToDisplay)
+		assert.Equal(t, CheckFail, web.Status)
+		require.Len(t, web.Instances, 2)
+		assert.Equal(t, "aws_instance.web[1]", web.Instances[1].Address.ToDisplay)
+		assert.Equal(t, CheckFail, web.Instances[1].Status)
+
+		output := out.Checks[1]
+		assert.Equal(t, "output_value", output.Address.Kind)
+		assert.Equal(t, "module.network", output.Address.Module)
+		assert.Equal(t, CheckPass, output.Status)
+
+		assert.Equal(t, CheckUnknown, out.Checks[3].Status)
+		assert.Empty(t, out.Checks[3].Instances)
+	})
+
+	t.Run("failure messages are collected over instances", func(t *testing.T) {
+		assert.Equal(t, []string{"The instance type must be in the t3 family."}, out.Checks[0].FailureMessages())
+		assert.Empty(t, out.Checks[1].FailureMessages())
+	})
+
+	t.Run("failed checks include errored checks", func(t *testing.T) {
+		failed := out.FailedChecks()
+		require.Len(t, failed, 2)
+		assert.Equal(t, "aws_instance.web", failed[0].Address.ToDisplay)
+		assert.Equal(t, "check.health", failed[1].Address.ToDisplay)
+		assert.Equal(t, []string{"The health endpoint returned 503."}, failed[1].FailureMessages())
+	})
+}
+
+func TestPlansReadJSONOutputStruct_Decode(t *testing.T) {
+	ctx := context.Background()
+
+	fixture, err := os.ReadFile("test-fixtures/json-plan/format-1.1.json")
+	require.NoError(t, err)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.Header().Set("Content-Type", ContentTypeJSONAPI)
+			w.WriteHeader(204)
+		case "/api/v2/plans/plan-valid/json-output":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(fixture)
+		case "/api/v2/plans/plan-invalid/json-output":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"format_version":`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "abcd1234",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	t.Run("with a valid JSON plan", func(t *testing.T) {
+		out, err := client.Plans.ReadJSONOutputStruct(ctx, "plan-valid")
+		require.NoError(t, err)
+		assert.Equal(t, "1.1", out.FormatVersion)
+		assert.Len(t, out.ResourceChanges, 3)
+	})
+
+	t.Run("with an invalid JSON plan", func(t *testing.T) {
+		out, err := client.Plans.ReadJSONOutputStruct(ctx, "plan-invalid")
+		assert.Nil(t, out)
+		assert.Error(t, err)
+	})
+}
+
+func TestPlansReadOutputChanges(t *testing.T) {
+	ctx := context.Background()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/plans/plan-outputs/json-output-redacted":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{
+  "format_version": "1.1",
+  "output_changes": {
+    "endpoint": {
+      "actions": ["update"],
+      "before": "https://old.example.com",
+      "after": "https://new.example.com",
+      "after_unknown": false,
+      "before_sensitive": false,
+      "after_sensitive": false
+    },
+    "token": {
+      "actions": ["create"],
+      "before": null,
+      "after_unknown": false,
+      "before_sensitive": false,
+      "after_sensitive": true
+    }
+  }
+}`))
+		case "/api/v2/plans/plan-resources/json-output-redacted":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"format_version":"1.1","resource_changes":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+
+	t.Run("with output changes", func(t *testing.T) {
+		changes, err := client.Plans.ReadOutputChanges(ctx, "plan-outputs")
+		require.NoError(t, err)
+		require.Len(t, changes, 2)
+
+		endpoint := changes["endpoint"]
+		assert.Equal(t, []string{"update"}, endpoint.Actions)
+		assert.Equal(t, "https://old.example.com", endpoint.Before)
+		assert.Equal(t, "https://new.example.com", endpoint.After)
+
+		token := changes["token"]
+		assert.Equal(t, []string{"create"}, token.Actions)
+		assert.Nil(t, token.After)
+		assert.Equal(t, true, token.AfterSensitive)
+	})
+
+	t.Run("without output changes", func(t *testing.T) {
+		changes, err := client.Plans.ReadOutputChanges(ctx, "plan-resources")
+		require.NoError(t, err)
+		assert.NotNil(t, changes)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("when the plan does not exist", func(t *testing.T) {
+		changes, err := client.Plans.ReadOutputChanges(ctx, "plan-nonexisting")
+		assert.Nil(t, changes)
+		assert.Error(t, err)
+	})
+
+	t.Run("with an invalid plan ID", func(t *testing.T) {
+		changes, err := client.Plans.ReadOutputChanges(ctx, badIdentifier)
+		assert.Nil(t, changes)
+		assert.Equal(t, ErrInvalidPlanID, err)
+	})
+}
+
+func TestPlansReadJSONOutput_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		planID := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v2/plans/"), "/")[0]
+		switch {
+		case r.URL.Path == "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case planID == "plan-forbidden" && strings.Contains(r.URL.Path, "/json-output"):
+			w.WriteHeader(http.StatusForbidden)
+		case strings.Contains(r.URL.Path, "/json-output"):
+			w.WriteHeader(http.StatusNotFound)
+		case planID == "plan-running" || planID == "plan-finished" || planID == "plan-errored":
+			w.Header().Set("Content-Type", ContentTypeJSONAPI)
+			status := strings.TrimPrefix(planID, "plan-")
+			fmt.Fprintf(w, `{"data":{"id":%q,"type":"plans","attributes":{"status":%q}}}`, planID, status)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		planID string
+		err    error
+	}{
+		"when the plan has not finished":  {planID: "plan-running", err: ErrPlanJSONNotReady},
+		"when the JSON plan is missing":   {planID: "plan-finished", err: ErrResourceNotFound},
+		"when the JSON plan is forbidden": {planID: "plan-forbidden", err: ErrPlanJSONUnauthorized},
+		"when the plan errored":           {planID: "plan-errored", err: ErrResourceNotFound},
+		"when the plan does not exist":    {planID: "plan-missing", err: ErrResourceNotFound},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.Plans.ReadJSONOutput(ctx, tc.planID)
+			assert.Equal(t, tc.err, err)
+
+			_, err = client.Plans.ReadResourceChanges(ctx, tc.planID)
+			assert.Equal(t, tc.err, err)
+
+			err = client.Plans.StreamResourceChanges(ctx, tc.planID, func(ResourceChange) error { return nil })
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestPlansRead_ErrorsPayload(t *testing.T) {
+	ctx := context.Background()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/plans/plan-elsewhere", "/api/v2/plans/plan-elsewhere/json-output":
+			w.Header().Set("Content-Type", ContentTypeJSONAPI)
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errors":[{"status":"404","title":"not found","detail":"plan not found in this organization"}]}`)
+		case "/api/v2/plans/plan-invalid":
+			w.Header().Set("Content-Type", ContentTypeJSONAPI)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"errors":[{"status":"422","title":"invalid attribute","detail":"plan ID is malformed"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+
+	t.Run("when the response has JSON:API errors", func(t *testing.T) {
+		p, err := client.Plans.Read(ctx, "plan-elsewhere")
+		assert.Nil(t, p)
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+		assert.EqualError(t, err, "resource not found: not found\n\nplan not found in this organization")
+
+		var payload *ErrorsPayload
+		require.True(t, errors.As(err, &payload))
+		assert.Equal(t, http.StatusNotFound, payload.StatusCode)
+		require.Len(t, payload.Errors, 1)
+		assert.Equal(t, "404", payload.Errors[0].Status)
+		assert.Equal(t, "not found", payload.Errors[0].Title)
+		assert.Equal(t, "plan not found in this organization", payload.Errors[0].Detail)
+	})
+
+	t.Run("when the error is not mapped to a known error", func(t *testing.T) {
+		p, err := client.Plans.Read(ctx, "plan-invalid")
+		assert.Nil(t, p)
+		assert.EqualError(t, err, "invalid attribute\n\nplan ID is malformed")
+
+		var payload *ErrorsPayload
+		require.True(t, errors.As(err, &payload))
+		assert.Equal(t, http.StatusUnprocessableEntity, payload.StatusCode)
+	})
+
+	t.Run("with other plan methods", func(t *testing.T) {
+		_, err := client.Plans.ReadJSONOutput(ctx, "plan-elsewhere")
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+
+		var payload *ErrorsPayload
+		assert.True(t, errors.As(err, &payload))
+	})
+
+	t.Run("when the response has no JSON:API errors", func(t *testing.T) {
+		p, err := client.Plans.Read(ctx, "plan-missing")
+		assert.Nil(t, p)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+}
+
+func TestPlansCancel(t *testing.T) {
+	ctx := context.Background()
+
+	var actions []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/api/v2/plans/"):
+			assert.Equal(t, "run", r.URL.Query().Get("include"))
+			planID := strings.TrimPrefix(r.URL.Path, "/api/v2/plans/")
+
+			// Plan IDs are made of the plan status and the run actions.
+			status, runActions, _ := strings.Cut(strings.TrimPrefix(planID, "plan-"), ".")
+			w.Header().Set("Content-Type", ContentTypeJSONAPI)
+			fmt.Fprintf(w, `{
+				"data":{"id":%q,"type":"plans","attributes":{"status":%q},"relationships":{"run":{"data":{"id":"run-123","type":"runs"}}}},
+				"included":[{"id":"run-123","type":"runs","attributes":{"actions":{"is-cancelable":%t,"is-force-cancelable":%t}}}]
+			}`, planID, status, runActions != "none" && runActions != "force", runActions == "force")
+		case r.Method == "POST":
+			actions = append(actions, r.URL.Path)
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+
+	for planID, tc := range map[string]struct {
+		action string
+		err    error
+	}{
+		"plan-created":           {action: "/api/v2/runs/run-123/actions/cancel"},
+		"plan-pending":           {action: "/api/v2/runs/run-123/actions/cancel"},
+		"plan-queued":            {action: "/api/v2/runs/run-123/actions/cancel"},
+		"plan-running":           {action: "/api/v2/runs/run-123/actions/cancel"},
+		"plan-running.force":     {action: "/api/v2/runs/run-123/actions/force-cancel"},
+		"plan-mfa_waiting":       {action: "/api/v2/runs/run-123/actions/cancel", err: ErrPlanCancelPending},
+		"plan-mfa_waiting.none":  {err: ErrPlanCancelPending},
+		"plan-mfa_waiting.force": {action: "/api/v2/runs/run-123/actions/force-cancel"},
+		"plan-canceled":          {},
+		"plan-errored":           {},
+		"plan-finished":          {},
+		"plan-unreachable":       {},
+	} {
+		t.Run(fmt.Sprintf("when the plan is %s", strings.TrimPrefix(planID, "plan-")), func(t *testing.T) {
+			actions = nil
+			err := client.Plans.Cancel(ctx, planID)
+			assert.Equal(t, tc.err, err)
+
+			if tc.action == "" {
+				assert.Empty(t, actions)
+			} else {
+				assert.Equal(t, []string{tc.action}, actions)
+			}
+		})
+	}
+
+	t.Run("with an invalid plan ID", func(t *testing.T) {
+		err := client.Plans.Cancel(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidPlanID, err)
+	})
+}
+
+func TestPlansReadDiagnostics(t *testing.T) {
+	ctx := context.Background()
+
+	fixture, err := os.ReadFile("test-fixtures/plan-logs/structured.log")
+	require.NoError(t, err)
+	logs := "\x02" + string(fixture) + "\x03"
+
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/plans/plan-123":
+			w.Header().Set("Content-Type", ContentTypeJSONAPI)
+			fmt.Fprintf(w, `{"data":{"id":"plan-123","type":"plans","attributes":{"status":"finished","log-read-url":%q}}}`, ts.URL+"/logs")
+		case "/logs":
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			if offset < len(logs) {
+				fmt.Fprint(w, logs[offset:])
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+
+	t.Run("with structured logs", func(t *testing.T) {
+		diagnostics, err := client.Plans.ReadDiagnostics(ctx, "plan-123")
+		require.NoError(t, err)
+
+		assert.Equal(t, []Diagnostic{
+			{
+				Severity: DiagnosticWarning,
+				Summary:  "Argument is deprecated",
+				Detail:   "Use the aws_s3_bucket_acl resource instead.",
+				Address:  "aws_s3_bucket.logs",
+				Range: &DiagnosticRange{
+					Filename: "main.tf",
+					Start:    DiagnosticPos{Line: 12, Column: 3, Byte: 210},
+					End:      DiagnosticPos{Line: 12, Column: 6, Byte: 213},
+				},
+			},
+			{
+				Severity: DiagnosticError,
+				Summary:  "Unsupported argument",
+				Detail:   `An argument named "colour" is not expected here.`,
+			},
+		}, diagnostics)
+	})
+
+	t.Run("with plain logs", func(t *testing.T) {
+		diagnostics, err := decodeDiagnostics(strings.NewReader("Terraform v1.6.2\nPlan: 1 to add, 0 to change, 0 to destroy.\n"))
+		require.NoError(t, err)
+		assert.Empty(t, diagnostics)
+	})
+
+	t.Run("when the plan does not exist", func(t *testing.T) {
+		diagnostics, err := client.Plans.ReadDiagnostics(ctx, "plan-nonexisting")
+		assert.Nil(t, diagnostics)
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+	})
+
+	t.Run("with an invalid plan ID", func(t *testing.T) {
+		diagnostics, err := client.Plans.ReadDiagnostics(ctx, badIdentifier)
+		assert.Nil(t, diagnostics)
+		assert.Equal(t, ErrInvalidPlanID, err)
+	})
+}
+
+func TestPlansReadResourceChanges(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	rTest, rTestCleanup := createPlannedRun(t, client, nil)
+	defer rTestCleanup()
+
+	t.Run("when resource changes exist for the plan", func(t *testing.T) {
+		resourceChanges, err := client.Plans.ReadResourceChanges(ctx, rTest.Plan.ID)
+		require.NoError(t, err)
+		assert.NotEmpty(t, resourceChanges.ResourceChanges)
+	})
+
+	t.Run("when the plan does not exist", func(t *testing.T) {
+		resourceChanges, err := client.Plans.ReadResourceChanges(ctx, "nonexisting")
+		assert.Nil(t, resourceChanges)
 		assert.Error(t, err)
 	})
 }
+
+func TestPlanResourceChanges_RelevantAttributes(t *testing.T) {
+	data, err := os.ReadFile("test-fixtures/json-plan/drift.json")
+	require.NoError(t, err)
+
+	var changes PlanResourceChanges
+	require.NoError(t, json.Unmarshal(data, &changes))
+
+	require.Len(t, changes.ResourceChanges, 1)
+	assert.Equal(t, []ResourceAttr{
+		{Resource: "aws_instance.web", Attribute: []interface{}{"instance_type"}},
+		{Resource: "aws_instance.web", Attribute: []interface{}{"tags", "Name"}},
+	}, changes.RelevantAttributes)
+}
+
+func TestPlanResourceChanges_ResourceDrift(t *testing.T) {
+	data, err := os.ReadFile("test-fixtures/json-plan/drift.json")
+	require.NoError(t, err)
+
+	var changes PlanResourceChanges
+	require.NoError(t, json.Unmarshal(data, &changes))
+
+	require.Len(t, changes.ResourceDrift, 1)
+	drift := changes.ResourceDrift[0]
+	assert.Equal(t, "aws_instance.web", drift.Address)
+	assert.Equal(t, "t3.micro", drift.Change.Before.(map[string]interface{})["instance_type"])
+	assert.Equal(t, "t3.small", drift.Change.After.(map[string]interface{})["instance_type"])
+
+	t.Run("drift is kept apart from the planned changes", func(t *testing.T) {
+		require.Len(t, changes.ResourceChanges, 1)
+		assert.Equal(t, "t3.small", changes.ResourceChanges[0].Change.Before.(map[string]interface{})["instance_type"])
+	})
+}
+
+func TestPlanResourceChanges_SensitiveResources(t *testing.T) {
+	var changes PlanResourceChanges
+	require.NoError(t, json.Unmarshal([]byte(`{
+  "resource_changes": [
+    {"address": "aws_instance.web", "change": {"actions": ["update"], "before_sensitive": {}, "after_sensitive": {}}},
+    {"address": "random_password.db", "change": {"actions": ["create"], "before_sensitive": false, "after_sensitive": true}},
+    {"address": "aws_db_instance.main", "change": {"actions": ["update"], "before_sensitive": {"tags": {"Secret": true}}, "after_sensitive": {}}},
+    {"address": "aws_ssm_parameter.keys", "change": {"actions": ["create"], "before_sensitive": false, "after_sensitive": {"values": [false, true]}}},
+    {"address": "null_resource.none", "change": {"actions": ["delete"], "before_sensitive": {"triggers": {"a": false}}, "after_sensitive": false}}
+  ]
+}`), &changes))
+
+	assert.Equal(t, []string{
+		"random_password.db",
+		"aws_db_instance.main",
+		"aws_ssm_parameter.keys",
+	}, changes.SensitiveResources())
+
+	t.Run("without sensitive values", func(t *testing.T) {
+		assert.Empty(t, (&PlanResourceChanges{}).SensitiveResources())
+	})
+}
+
+func TestPlanResourceChanges_Configuration(t *testing.T) {
+	data, err := os.ReadFile("test-fixtures/json-plan/configuration.json")
+	require.NoError(t, err)
+
+	var changes PlanResourceChanges
+	require.NoError(t, json.Unmarshal(data, &changes))
+
+	config := changes.Configuration
+	require.NotNil(t, config)
+
+	t.Run("provider configs are decoded", func(t *testing.T) {
+		require.Contains(t, config.ProviderConfigs, "aws")
+		aws := config.ProviderConfigs["aws"]
+		assert.Equal(t, "registry.terraform.io/hashicorp/aws", aws.FullName)
+		assert.Equal(t, "~> 5.0", aws.VersionConstraint)
+		assert.JSONEq(t, `{"references":["var.region"]}`, string(aws.Expressions["region"]))
+	})
+
+	t.Run("resources are decoded", func(t *testing.T) {
+		require.Len(t, config.RootModule.Resources, 1)
+		r := config.RootModule.Resources[0]
+		assert.Equal(t, "aws_instance.web", r.Address)
+		assert.Equal(t, "aws", r.ProviderConfigKey)
+		assert.Equal(t, 1, r.SchemaVersion)
+		assert.JSONEq(t, `{"constant_value":"t3.micro"}`, string(r.Expressions["instance_type"]))
+		require.NotNil(t, r.CountExpression)
+		assert.JSONEq(t, `2`, string(r.CountExpression.ConstantValue))
+		assert.Nil(t, r.ForEachExpression)
+		assert.Equal(t, []string{"data.aws_ami.ubuntu", "data.aws_ami.ubuntu.id", "var.volume_size"}, r.References())
+	})
+
+	t.Run("outputs and variables are decoded", func(t *testing.T) {
+		ip := config.RootModule.Outputs["ip"]
+		assert.True(t, ip.Sensitive)
+		assert.Equal(t, []string{"aws_instance.web.public_ip", "aws_instance.web"}, ip.Expression.References)
+
+		assert.JSONEq(t, `"us-east-1"`, string(config.RootModule.Variables["region"].Default))
+		assert.Equal(t, "The AWS region", config.RootModule.Variables["region"].Description)
+		assert.True(t, config.RootModule.Variables["volume_size"].Sensitive)
+	})
+
+	t.Run("module calls are decoded", func(t *testing.T) {
+		require.Contains(t, config.RootModule.ModuleCalls, "network")
+		network := config.RootModule.ModuleCalls["network"]
+		assert.Equal(t, "./modules/network", network.Source)
+		require.Len(t, network.Module.Resources, 1)
+		assert.Equal(t, "network:aws", network.Module.Resources[0].ProviderConfigKey)
+		assert.Equal(t, []string{"var.cidr"}, network.Module.Resources[0].References())
+	})
+}
+
+func TestPlansStreamResourceChanges(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	rTest, rTestCleanup := createPlannedRun(t, client, nil)
+	defer rTestCleanup()
+
+	t.Run("when resource changes exist for the plan", func(t *testing.T) {
+		resourceChanges, err := client.Plans.ReadResourceChanges(ctx, rTest.Plan.ID)
+		require.NoError(t, err)
+
+		var streamed []ResourceChange
+		err = client.Plans.StreamResourceChanges(ctx, rTest.Plan.ID, func(rc ResourceChange) error {
+			streamed = append(streamed, rc)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, resourceChanges.ResourceChanges, streamed)
+	})
+
+	t.Run("when the plan does not exist", func(t *testing.T) {
+		err := client.Plans.StreamResourceChanges(ctx, "nonexisting", func(rc ResourceChange) error {
+			return nil
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("with an invalid plan ID", func(t *testing.T) {
+		err := client.Plans.StreamResourceChanges(ctx, badIdentifier, func(rc ResourceChange) error {
+			return nil
+		})
+		assert.EqualError(t, err, ErrInvalidPlanID.Error())
+	})
+}
+
+func TestPlansReadExport(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	rTest, rTestCleanup := createPlannedRun(t, client, nil)
+	t.Cleanup(rTestCleanup)
+
+	t.Run("without an export", func(t *testing.T) {
+		var buf bytes.Buffer
+		pe, err := client.Plans.ReadExport(ctx, rTest.Plan.ID, &buf, PlanReadExportOptions{})
+		assert.Nil(t, pe)
+		assert.Equal(t, ErrPlanExportTypeNotFound, err)
+	})
+
+	t.Run("with an export", func(t *testing.T) {
+		peTest, peTestCleanup := createPlanExport(t, client, rTest)
+		t.Cleanup(peTestCleanup)
+
+		var buf bytes.Buffer
+		pe, err := client.Plans.ReadExport(ctx, rTest.Plan.ID, &buf, PlanReadExportOptions{
+			Wait: &WaitOptions{MaxWait: 5 * time.Minute},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, peTest.ID, pe.ID)
+		assert.NotEmpty(t, buf.Bytes())
+	})
+
+	t.Run("with invalid plan ID", func(t *testing.T) {
+		var buf bytes.Buffer
+		pe, err := client.Plans.ReadExport(ctx, badIdentifier, &buf, PlanReadExportOptions{})
+		assert.Nil(t, pe)
+		assert.Equal(t, ErrInvalidPlanID, err)
+	})
+}
+
+func TestPlansReadExport_Exports(t *testing.T) {
+	ctx := context.Background()
+
+	reads := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(204)
+		case "/api/v2/plans/plan-finished":
+			w.Write([]byte(`{"data":{"type":"plans","id":"plan-finished","relationships":{"exports":{"data":[
+				{"type":"plan-exports","id":"pe-errored"},{"type":"plan-exports","id":"pe-finished"}]}}}}`))
+		case "/api/v2/plans/plan-pending":
+			w.Write([]byte(`{"data":{"type":"plans","id":"plan-pending","relationships":{"exports":{"data":[
+				{"type":"plan-exports","id":"pe-pending"}]}}}}`))
+		case "/api/v2/plans/plan-errored":
+			w.Write([]byte(`{"data":{"type":"plans","id":"plan-errored","relationships":{"exports":{"data":[
+				{"type":"plan-exports","id":"pe-errored"}]}}}}`))
+		case "/api/v2/plan-exports/pe-errored", "/api/v2/plan-exports/pe-finished":
+			id := strings.TrimPrefix(r.URL.Path, "/api/v2/plan-exports/")
+			fmt.Fprintf(w, `{"data":{"type":"plan-exports","id":%q,"attributes":{"status":%q,"data-type":"sentinel-mock-bundle-v0"}}}`,
+				id, strings.TrimPrefix(id, "pe-"))
+		case "/api/v2/plan-exports/pe-pending":
+			reads++
+			status := "queued"
+			if reads > 2 {
+				status = "finished"
+			}
+			fmt.Fprintf(w, `{"data":{"type":"plan-exports","id":"pe-pending","attributes":{"status":%q,"data-type":"sentinel-mock-bundle-v0"}}}`, status)
+		case "/api/v2/plan-exports/pe-finished/download", "/api/v2/plan-exports/pe-pending/download":
+			w.Header().Set("Content-Type", "application/gzip")
+			w.Write([]byte("mock data of " + strings.Split(r.URL.Path, "/")[4]))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "abcd1234",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	t.Run("with a finished export", func(t *testing.T) {
+		var buf bytes.Buffer
+		pe, err := client.Plans.ReadExport(ctx, "plan-finished", &buf, PlanReadExportOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "pe-finished", pe.ID)
+		assert.Equal(t, "mock data of pe-finished", buf.String())
+	})
+
+	t.Run("with a pending export and no wait", func(t *testing.T) {
+		reads = 0
+
+		var buf bytes.Buffer
+		pe, err := client.Plans.ReadExport(ctx, "plan-pending", &buf, PlanReadExportOptions{})
+		assert.Nil(t, pe)
+		assert.Equal(t, ErrPlanExportNotReady, err)
+		assert.Empty(t, buf.Bytes())
+	})
+
+	t.Run("with a pending export and a wait", func(t *testing.T) {
+		reads = 0
+
+		var buf bytes.Buffer
+		pe, err := client.Plans.ReadExport(ctx, "plan-pending", &buf, PlanReadExportOptions{
+			Wait: &WaitOptions{PollInterval: time.Millisecond},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, PlanExportFinished, pe.Status)
+		assert.Equal(t, "mock data of pe-pending", buf.String())
+		assert.Equal(t, 3, reads)
+	})
+
+	t.Run("with an errored export", func(t *testing.T) {
+		var buf bytes.Buffer
+		pe, err := client.Plans.ReadExport(ctx, "plan-errored", &buf, PlanReadExportOptions{})
+		assert.Nil(t, pe)
+		assert.ErrorIs(t, err, ErrPlanExportNotFinished)
+	})
+
+	t.Run("with another data type", func(t *testing.T) {
+		var buf bytes.Buffer
+		pe, err := client.Plans.ReadExport(ctx, "plan-finished", &buf, PlanReadExportOptions{
+			DataType: PlanExportType("other"),
+		})
+		assert.Nil(t, pe)
+		assert.Equal(t, ErrPlanExportTypeNotFound, err)
+	})
+}
+
+func TestDecodeResourceChanges(t *testing.T) {
+	plan := `{
+		"format_version": "1.2",
+		"planned_values": {"root_module": {"resources": [{"address": "null_resource.a"}]}},
+		"resource_changes": [
+			{"address": "null_resource.a", "change": {"actions": ["create"]}},
+			{"address": "null_resource.b", "change": {"actions": ["delete"]}}
+		],
+		"configuration": {}
+	}`
+
+	t.Run("with resource changes", func(t *testing.T) {
+		var addresses []string
+		err := decodeResourceChanges(strings.NewReader(plan), func(rc ResourceChange) error {
+			addresses = append(addresses, rc.Address)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"null_resource.a", "null_resource.b"}, addresses)
+	})
+
+	t.Run("without resource changes", func(t *testing.T) {
+		calls := 0
+		err := decodeResourceChanges(strings.NewReader(`{"format_version": "1.2", "resource_changes": null}`), func(rc ResourceChange) error {
+			calls++
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Zero(t, calls)
+	})
+
+	t.Run("when the callback fails", func(t *testing.T) {
+		stop := errors.New("stop")
+		calls := 0
+		err := decodeResourceChanges(strings.NewReader(plan), func(rc ResourceChange) error {
+			calls++
+			return stop
+		})
+		assert.Equal(t, stop, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("with invalid JSON", func(t *testing.T) {
+		err := decodeResourceChanges(strings.NewReader(`{"resource_changes": [{`), func(rc ResourceChange) error {
+			return nil
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestPlanResourceChanges_WriteNDJSON(t *testing.T) {
+	changes := &PlanResourceChanges{
+		ResourceChanges: []ResourceChange{
+			{Address: "null_resource.a", Mode: "managed", Change: Change{Actions: []string{"create"}}},
+			{Address: "null_resource.b", Mode: "managed", Change: Change{Actions: []string{"delete"}}},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, changes.WriteNDJSON(&buf))
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+
+	for i, line := range lines {
+		var rc ResourceChange
+		require.NoError(t, json.Unmarshal([]byte(line), &rc))
+		assert.Equal(t, changes.ResourceChanges[i], rc)
+	}
+}
+
+func TestPlanResourceChanges_CountByModule(t *testing.T) {
+	changes := &PlanResourceChanges{
+		ResourceChanges: []ResourceChange{
+			{Address: "aws_vpc.main", Change: Change{Actions: []string{"create"}}},
+			{Address: "data.aws_ami.ubuntu", Change: Change{Actions: []string{"read"}}},
+			{Address: "module.network.aws_subnet.a", Change: Change{Actions: []string{"update"}}},
+			{Address: "module.network.aws_subnet.b", Change: Change{Actions: []string{"delete", "create"}}},
+			{Address: "module.network.module.subnets[0].aws_subnet.c", Change: Change{Actions: []string{"delete"}}},
+			{Address: `module.network.module.subnets["a.b"].aws_subnet.d`, Change: Change{Actions: []string{"create", "delete"}}},
+			{Address: "module.compute.data.aws_ami.this", Change: Change{Actions: []string{"no-op"}}},
+		},
+	}
+
+	counts := changes.CountByModule()
+
+	assert.Equal(t, map[string]PlanChangeSummary{
+		"":                                     {Add: 1},
+		"module.network":                       {Add: 1, Change: 1, Destroy: 1},
+		"module.network.module.subnets[0]":     {Destroy: 1},
+		`module.network.module.subnets["a.b"]`: {Add: 1, Destroy: 1},
+		"module.compute":                       {},
+	}, counts)
+}
+
+func TestPlanResourceChanges_Imports(t *testing.T) {
+	data, err := os.ReadFile("test-fixtures/json-plan/import.json")
+	require.NoError(t, err)
+
+	var changes PlanResourceChanges
+	require.NoError(t, json.Unmarshal(data, &changes))
+	require.Len(t, changes.ResourceChanges, 3)
+
+	logs := changes.ResourceChanges[0].Change
+	require.NotNil(t, logs.Importing)
+	assert.Equal(t, "acme-logs", logs.Importing.ID)
+	assert.Empty(t, logs.GeneratedConfig)
+
+	assets := changes.ResourceChanges[1].Change
+	require.NotNil(t, assets.Importing)
+	assert.Contains(t, assets.GeneratedConfig, `bucket = "acme-assets"`)
+
+	assert.Nil(t, changes.ResourceChanges[2].Change.Importing)
+
+	assert.Equal(t, map[string]string{
+		"aws_s3_bucket.logs":   "acme-logs",
+		"aws_s3_bucket.assets": "acme-assets",
+	}, changes.Imports())
+}
+
+func TestChange_SensitivePaths(t *testing.T) {
+	var c Change
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"actions": ["update"],
+		"before_sensitive": {"password": true, "tags": {}},
+		"after_sensitive": {
+			"password": true,
+			"port": false,
+			"tags": {"Secret": true, "team/owner": true, "Name": false},
+			"ingress": [{"cidr_blocks": [false, true]}, {"description": true}],
+			"settings": [],
+			"labels": null
+		}
+	}`), &c))
+
+	assert.Equal(t, []string{
+		"ingress[0].cidr_blocks[1]",
+		"ingress[1].description",
+		"password",
+		"tags.Secret",
+		`tags["team/owner"]`,
+	}, c.SensitivePaths())
+
+	t.Run("when the whole value is sensitive", func(t *testing.T) {
+		c := Change{BeforeSensitive: false, AfterSensitive: true}
+		assert.Equal(t, []string{""}, c.SensitivePaths())
+	})
+
+	t.Run("without sensitive values", func(t *testing.T) {
+		c := Change{BeforeSensitive: map[string]interface{}{}, AfterSensitive: false}
+		assert.Empty(t, c.SensitivePaths())
+	})
+}
+
+func TestChange_RenderDiff(t *testing.T) {
+	var c Change
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"actions": ["update"],
+		"before": {
+			"ami": "ami-123",
+			"id": "i-123",
+			"instance_type": "t3.micro",
+			"password": "old",
+			"tags": {"Name": "web", "Env": "dev"},
+			"ports": [80, 443],
+			"user_data": null
+		},
+		"after": {
+			"ami": "ami-123",
+			"instance_type": "t3.small",
+			"password": "new",
+			"tags": {"Name": "web", "Team": "platform"},
+			"ports": [80],
+			"monitoring": true
+		},
+		"after_unknown": {"id": true, "tags": {}},
+		"before_sensitive": {"password": true},
+		"after_sensitive": {"password": true, "tags": {}}
+	}`), &c))
+
+	assert.Equal(t, `~ id = "i-123" -> (known after apply)
+~ instance_type = "t3.micro" -> "t3.small"
++ monitoring = true
+~ password = (sensitive value) -> (sensitive value)
+- ports[1] = 443
+- tags.Env = "dev"
++ tags.Team = "platform"
+`, c.RenderDiff())
+
+	t.Run("when creating a resource", func(t *testing.T) {
+		c := Change{
+			Actions:      []string{"create"},
+			After:        map[string]interface{}{"name": "web", "token": "secret"},
+			AfterUnknown: map[string]interface{}{"arn": true},
+			AfterSensitive: map[string]interface{}{
+				"token": true,
+			},
+		}
+		assert.Equal(t, `+ arn = (known after apply)
++ name = "web"
++ token = (sensitive value)
+`, c.RenderDiff())
+	})
+
+	t.Run("when deleting a resource", func(t *testing.T) {
+		c := Change{
+			Actions: []string{"delete"},
+			Before:  map[string]interface{}{"name": "web", "labels": map[string]interface{}{"team/owner": "platform"}},
+		}
+		assert.Equal(t, `- labels["team/owner"] = "platform"
+- name = "web"
+`, c.RenderDiff())
+	})
+
+	t.Run("without changes", func(t *testing.T) {
+		c := Change{
+			Actions: []string{"no-op"},
+			Before:  map[string]interface{}{"name": "web"},
+			After:   map[string]interface{}{"name": "web"},
+		}
+		assert.Empty(t, c.RenderDiff())
+	})
+}
+
+func TestPlanResourceChanges_ChangesByAction(t *testing.T) {
+	changes := &PlanResourceChanges{
+		ResourceChanges: []ResourceChange{
+			{Address: "aws_vpc.main", Mode: "managed", Change: Change{Actions: []string{"create"}}},
+			{Address: "data.aws_ami.ubuntu", Mode: "data", Change: Change{Actions: []string{"read"}}},
+			{Address: "aws_subnet.a", Mode: "managed", Change: Change{Actions: []string{"update"}}},
+			{Address: "aws_subnet.b", Mode: "managed", Change: Change{Actions: []string{"delete", "create"}}},
+			{Address: "aws_subnet.c", Mode: "managed", Change: Change{Actions: []string{"create", "delete"}}},
+			{Address: "aws_subnet.d", Mode: "managed", Change: Change{Actions: []string{"no-op"}}},
+		},
+	}
+
+	addresses := func(rcs []ResourceChange) []string {
+		var addrs []string
+		for _, rc := range rcs {
+			addrs = append(addrs, rc.Address)
+		}
+		return addrs
+	}
+
+	assert.Equal(t, []string{"aws_vpc.main"}, addresses(changes.ChangesByAction("create")))
+	assert.Equal(t, []string{"aws_subnet.a"}, addresses(changes.ChangesByAction("update")))
+	assert.Equal(t, []string{"aws_subnet.b", "aws_subnet.c"}, addresses(changes.ChangesByAction("replace")))
+	assert.Equal(t, []string{"aws_subnet.d"}, addresses(changes.ChangesByAction("no-op")))
+	assert.Empty(t, changes.ChangesByAction("delete"))
+	assert.Equal(t, []string{"data.aws_ami.ubuntu"}, addresses(changes.ChangesByMode("data")))
+	assert.Len(t, changes.ChangesByMode("managed"), 5)
+	assert.True(t, changes.HasDestructiveChanges())
+
+	t.Run("without destructive changes", func(t *testing.T) {
+		changes := &PlanResourceChanges{
+			ResourceChanges: []ResourceChange{
+				{Address: "aws_vpc.main", Change: Change{Actions: []string{"create"}}},
+				{Address: "aws_subnet.a", Change: Change{Actions: []string{"update"}}},
+			},
+		}
+		assert.False(t, changes.HasDestructiveChanges())
+
+		changes.ResourceChanges = append(changes.ResourceChanges,
+			ResourceChange{Address: "aws_subnet.b", Change: Change{Actions: []string{"delete"}}})
+		assert.True(t, changes.HasDestructiveChanges())
+	})
+}
+
+func TestComparePlans(t *testing.T) {
+	a := &PlanResourceChanges{
+		ResourceChanges: []ResourceChange{
+			{Address: "null_resource.same", Change: Change{Actions: []string{"create"}, After: map[string]interface{}{"id": "1"}}},
+			{Address: "null_resource.removed", Change: Change{Actions: []string{"delete"}}},
+			{Address: "null_resource.values", Change: Change{Actions: []string{"update"}, After: map[string]interface{}{"size": float64(1)}}},
+			{Address: "null_resource.actions", Change: Change{Actions: []string{"update"}}},
+			{Address: "null_resource.deposed", Deposed: "00000001", Change: Change{Actions: []string{"delete"}}},
+		},
+	}
+	b := &PlanResourceChanges{
+		ResourceChanges: []ResourceChange{
+			{Address: "null_resource.deposed", Change: Change{Actions: []string{"create"}}},
+			{Address: "null_resource.actions", Change: Change{Actions: []string{"delete", "create"}}, ActionReason: ChangeActionReasonReplaceByRequest},
+			{Address: "null_resource.values", Change: Change{Actions: []string{"update"}, After: map[string]interface{}{"size": float64(2)}}},
+			{Address: "null_resource.added", Change: Change{Actions: []string{"create"}}},
+			{Address: "null_resource.same", Change: Change{Actions: []string{"create"}, After: map[string]interface{}{"id": "1"}}},
+		},
+	}
+
+	t.Run("with differing plans", func(t *testing.T) {
+		diff := ComparePlans(a, b)
+		assert.False(t, diff.IsEmpty())
+
+		assert.Equal(t, []ResourceChange{b.ResourceChanges[3], b.ResourceChanges[0]}, diff.Added)
+		assert.Equal(t, []ResourceChange{a.ResourceChanges[4], a.ResourceChanges[1]}, diff.Removed)
+		assert.Equal(t, []PlanResourceChangeDiff{
+			{Address: "null_resource.actions", Before: a.ResourceChanges[3], After: b.ResourceChanges[1]},
+			{Address: "null_resource.values", Before: a.ResourceChanges[2], After: b.ResourceChanges[2]},
+		}, diff.Changed)
+	})
+
+	t.Run("with the same plan", func(t *testing.T) {
+		diff := ComparePlans(a, a)
+		assert.True(t, diff.IsEmpty())
+	})
+
+	t.Run("with a nil plan", func(t *testing.T) {
+		diff := ComparePlans(nil, b)
+		assert.Len(t, diff.Added, len(b.ResourceChanges))
+		assert.Empty(t, diff.Removed)
+		assert.Empty(t, diff.Changed)
+	})
+}
+
+func TestPlanResourceChanges_Unmarshal(t *testing.T) {
+	data := []byte(`{
+		"resource_changes": [
+			{
+				"address": "aws_instance.web",
+				"mode": "managed",
+				"type": "aws_instance",
+				"name": "web",
+				"provider_name": "registry.terraform.io/hashicorp/aws",
+				"action_reason": "replace_because_cannot_update",
+				"change": {
+					"actions": ["create", "delete"],
+					"before": {"ami": "ami-1"},
+					"after": {"ami": "ami-2"},
+					"replace_paths": [["ami"], ["network_interface", 0, "device_index"]]
+				}
+			},
+			{
+				"address": "aws_instance.old",
+				"mode": "managed",
+				"type": "aws_instance",
+				"name": "old",
+				"deposed": "00000001",
+				"action_reason": "delete_because_no_resource_config",
+				"change": {
+					"actions": ["delete"]
+				}
+			}
+		]
+	}`)
+
+	changes := &PlanResourceChanges{}
+	require.NoError(t, json.Unmarshal(data, changes))
+	require.Len(t, changes.ResourceChanges, 2)
+
+	replaced := changes.ResourceChanges[0]
+	assert.Equal(t, ChangeActionReasonReplaceBecauseCannotUpdate, replaced.ActionReason)
+	assert.Empty(t, replaced.Deposed)
+	assert.Equal(t, [][]interface{}{{"ami"}, {"network_interface", float64(0), "device_index"}}, replaced.Change.ReplacePaths)
+	assert.True(t, replaced.Change.IsReplace())
+	assert.True(t, replaced.Change.IsCreateBeforeDestroy())
+
+	deposed := changes.ResourceChanges[1]
+	assert.Equal(t, ChangeActionReasonDeleteBecauseNoResourceConfig, deposed.ActionReason)
+	assert.Equal(t, "00000001", deposed.Deposed)
+	assert.Nil(t, deposed.Change.ReplacePaths)
+	assert.False(t, deposed.Change.IsReplace())
+	assert.False(t, deposed.Change.IsCreateBeforeDestroy())
+
+	t.Run("when destroying before creating", func(t *testing.T) {
+		c := Change{Actions: []string{"delete", "create"}}
+		assert.True(t, c.IsReplace())
+		assert.False(t, c.IsCreateBeforeDestroy())
+	})
+}
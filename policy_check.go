@@ -204,39 +204,34 @@ func (s *policyChecks) Logs(ctx context.Context, policyCheckID string) (io.Reade
 		return nil, ErrInvalidPolicyCheckID
 	}
 
-	// Loop until the context is canceled or the policy check is finished
+	// Wait until the context is canceled or the policy check is finished
 	// running. The policy check logs are not streamed and so only available
 	// once the check is finished.
-	for {
+	err := wait(ctx, nil, func() (bool, error) {
 		pc, err := s.Read(ctx, policyCheckID)
 		if err != nil {
-			return nil, err
+			return false, err
 		}
 
-		switch pc.Status {
-		case PolicyPending, PolicyQueued:
-			select {
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			case <-time.After(500 * time.Millisecond):
-				continue
-			}
-		}
-
-		u := fmt.Sprintf("policy-checks/%s/output", url.QueryEscape(policyCheckID))
-		req, err := s.client.NewRequest("GET", u, nil)
-		if err != nil {
-			return nil, err
-		}
+		return pc.Status != PolicyPending && pc.Status != PolicyQueued, nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		logs := bytes.NewBuffer(nil)
-		err = req.Do(ctx, logs)
-		if err != nil {
-			return nil, err
-		}
+	u := fmt.Sprintf("policy-checks/%s/output", url.QueryEscape(policyCheckID))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
 
-		return logs, nil
+	logs := bytes.NewBuffer(nil)
+	err = req.Do(ctx, logs)
+	if err != nil {
+		return nil, err
 	}
+
+	return logs, nil
 }
 
 func (o *PolicyCheckListOptions) valid() error {
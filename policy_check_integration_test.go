@@ -240,6 +240,15 @@ func TestPolicyCheck_Unmarshal(t *testing.T) {
 					"result":          true,
 					"soft-failed":     1,
 					"total-failed":    1,
+					"sentinel": map[string]interface{}{
+						"schema-version": "1.0.0",
+						"data": map[string]interface{}{
+							"my-policy-set": map[string]interface{}{
+								"can-override": true,
+								"result":       false,
+							},
+						},
+					},
 				},
 				"scope":  PolicyScopeOrganization,
 				"status": PolicyOverridden,
@@ -274,6 +283,15 @@ func TestPolicyCheck_Unmarshal(t *testing.T) {
 	assert.Equal(t, pc.Result.Result, true)
 	assert.Equal(t, pc.Result.SoftFailed, 1)
 	assert.Equal(t, pc.Result.TotalFailed, 1)
+	assert.Equal(t, pc.Result.Sentinel, map[string]interface{}{
+		"schema-version": "1.0.0",
+		"data": map[string]interface{}{
+			"my-policy-set": map[string]interface{}{
+				"can-override": true,
+				"result":       false,
+			},
+		},
+	})
 	assert.Equal(t, pc.Scope, PolicyScopeOrganization)
 	assert.Equal(t, pc.Status, PolicyOverridden)
 	assert.Equal(t, pc.StatusTimestamps.QueuedAt, queuedParsedTime)
@@ -26,11 +26,25 @@ type Projects interface {
 	// Read a project by its ID.
 	Read(ctx context.Context, projectID string) (*Project, error)
 
+	// ReadWithOptions reads a project by its ID using the options supplied.
+	ReadWithOptions(ctx context.Context, projectID string, options *ProjectReadOptions) (*Project, error)
+
+	// ReadHealthSummary counts the workspaces of a project by the status
+	// of their current run.
+	ReadHealthSummary(ctx context.Context, projectID string) (*ProjectHealthSummary, error)
+
 	// Update a project.
 	Update(ctx context.Context, projectID string, options ProjectUpdateOptions) (*Project, error)
 
 	// Delete a project.
 	Delete(ctx context.Context, projectID string) error
+
+	// ListTagBindings lists the tag bindings bound directly on a project.
+	ListTagBindings(ctx context.Context, projectID string) ([]*TagBinding, error)
+
+	// ListEffectiveTagBindings lists the tag bindings that apply to a
+	// project, including the ones inherited from the organization.
+	ListEffectiveTagBindings(ctx context.Context, projectID string) ([]*EffectiveTagBinding, error)
 }
 
 // projects implements Projects
@@ -46,11 +60,39 @@ type ProjectList struct {
 
 // Project represents a Terraform Enterprise project
 type Project struct {
-	ID   string `jsonapi:"primary,projects"`
-	Name string `jsonapi:"attr,name"`
+	ID             string `jsonapi:"primary,projects"`
+	Name           string `jsonapi:"attr,name"`
+	WorkspaceCount int    `jsonapi:"attr,workspace-count"`
 
 	// Relations
-	Organization *Organization `jsonapi:"relation,organization"`
+	Organization         *Organization          `jsonapi:"relation,organization"`
+	EffectiveTagBindings []*EffectiveTagBinding `jsonapi:"relation,effective-tag-bindings,omitempty"`
+}
+
+// ProjectIncludeOpt represents the available options for include query params.
+type ProjectIncludeOpt string
+
+const (
+	ProjectEffectiveTagBindings ProjectIncludeOpt = "effective_tag_bindings"
+)
+
+// ProjectReadOptions represents the options for reading a project.
+type ProjectReadOptions struct {
+	// Optional: A list of relations to include.
+	Include []ProjectIncludeOpt `url:"include,omitempty"`
+}
+
+// ProjectHealthSummary represents the workspaces of a project counted by the
+// status of their current run.
+type ProjectHealthSummary struct {
+	ProjectID      string
+	WorkspaceCount int
+
+	// The number of workspaces that have never had a run.
+	WithoutRuns int
+
+	// The number of workspaces by the status of their current run.
+	RunStatuses map[RunStatus]int
 }
 
 // ProjectListOptions represents the options for listing projects
@@ -61,6 +103,14 @@ type ProjectListOptions struct {
 	// If multiple, comma separated values are specified, projects matching
 	// any of the names are returned.
 	Name string `url:"filter[names],omitempty"`
+
+	// Optional: A case-insensitive search query matched against project
+	// names.
+	Query string `url:"q,omitempty"`
+
+	// Optional: Only return projects bound to all of the given tags. A tag
+	// binding without a value matches any value for its key.
+	TagBindings []*TagBinding `url:"-"`
 }
 
 // ProjectCreateOptions represents the options for creating a project
@@ -73,6 +123,9 @@ type ProjectCreateOptions struct {
 
 	// Required: A name to identify the project.
 	Name string `jsonapi:"attr,name"`
+
+	// Optional: A list of key/value tags to bind to the project.
+	TagBindings []*TagBinding `jsonapi:"relation,tag-bindings,omitempty"`
 }
 
 // ProjectUpdateOptions represents the options for updating a project
@@ -85,6 +138,10 @@ type ProjectUpdateOptions struct {
 
 	// Optional: A name to identify the project
 	Name *string `jsonapi:"attr,name,omitempty"`
+
+	// Optional: A list of key/value tags to bind to the project. When set,
+	// it replaces all the tag bindings of the project.
+	TagBindings []*TagBinding `jsonapi:"relation,tag-bindings,omitempty"`
 }
 
 // List all projects.
@@ -93,8 +150,12 @@ func (s *projects) List(ctx context.Context, organization string, options *Proje
 		return nil, ErrInvalidOrg
 	}
 
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
 	u := fmt.Sprintf("organizations/%s/projects", url.QueryEscape(organization))
-	req, err := s.client.NewRequest("GET", u, options)
+	req, err := s.client.NewRequestWithAdditionalQueryParams("GET", u, options, options.buildQueryString())
 	if err != nil {
 		return nil, err
 	}
@@ -135,12 +196,21 @@ func (s *projects) Create(ctx context.Context, organization string, options Proj
 
 // Read a single project by its ID.
 func (s *projects) Read(ctx context.Context, projectID string) (*Project, error) {
+	return s.ReadWithOptions(ctx, projectID, nil)
+}
+
+// ReadWithOptions reads a single project by its ID using the options supplied.
+func (s *projects) ReadWithOptions(ctx context.Context, projectID string, options *ProjectReadOptions) (*Project, error) {
 	if !validStringID(&projectID) {
 		return nil, ErrInvalidProjectID
 	}
 
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
 	u := fmt.Sprintf("projects/%s", url.QueryEscape(projectID))
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequest("GET", u, options)
 	if err != nil {
 		return nil, err
 	}
@@ -154,6 +224,51 @@ func (s *projects) Read(ctx context.Context, projectID string) (*Project, error)
 	return p, nil
 }
 
+// ReadHealthSummary counts the workspaces of a project by the status of their
+// current run, reading the workspaces with their current run included.
+func (s *projects) ReadHealthSummary(ctx context.Context, projectID string) (*ProjectHealthSummary, error) {
+	p, err := s.Read(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if p.Organization == nil {
+		return nil, ErrInvalidOrg
+	}
+
+	summary := &ProjectHealthSummary{
+		ProjectID:   p.ID,
+		RunStatuses: make(map[RunStatus]int),
+	}
+
+	options := &WorkspaceListOptions{
+		ListOptions: ListOptions{PageSize: 100},
+		ProjectID:   p.ID,
+		Include:     []WSIncludeOpt{WSCurrentRun},
+	}
+	for {
+		wl, err := s.client.Workspaces.List(ctx, p.Organization.Name, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, w := range wl.Items {
+			summary.WorkspaceCount++
+			if w.CurrentRun == nil {
+				summary.WithoutRuns++
+				continue
+			}
+			summary.RunStatuses[w.CurrentRun.Status]++
+		}
+
+		if wl.Pagination == nil || wl.NextPage == 0 {
+			break
+		}
+		options.PageNumber = wl.NextPage
+	}
+
+	return summary, nil
+}
+
 // Update a project by its ID
 func (s *projects) Update(ctx context.Context, projectID string, options ProjectUpdateOptions) (*Project, error) {
 	if !validStringID(&projectID) {
@@ -194,6 +309,89 @@ func (s *projects) Delete(ctx context.Context, projectID string) error {
 	return req.Do(ctx, nil)
 }
 
+// ListTagBindings lists the tag bindings bound directly on a project.
+func (s *projects) ListTagBindings(ctx context.Context, projectID string) ([]*TagBinding, error) {
+	if !validStringID(&projectID) {
+		return nil, ErrInvalidProjectID
+	}
+
+	u := fmt.Sprintf("projects/%s/tag-bindings", url.QueryEscape(projectID))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tbl := &tagBindingList{}
+	err = req.Do(ctx, tbl)
+	if err != nil {
+		return nil, err
+	}
+
+	return tbl.Items, nil
+}
+
+// ListEffectiveTagBindings lists the tag bindings that apply to a project,
+// including the ones inherited from the organization.
+func (s *projects) ListEffectiveTagBindings(ctx context.Context, projectID string) ([]*EffectiveTagBinding, error) {
+	if !validStringID(&projectID) {
+		return nil, ErrInvalidProjectID
+	}
+
+	u := fmt.Sprintf("projects/%s/effective-tag-bindings", url.QueryEscape(projectID))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	etbl := &effectiveTagBindingList{}
+	err = req.Do(ctx, etbl)
+	if err != nil {
+		return nil, err
+	}
+	etbl.setSources(TagBindingSourceProject)
+
+	return etbl.Items, nil
+}
+
+func (o *ProjectListOptions) valid() error {
+	if o == nil {
+		return nil // nothing to validate
+	}
+
+	for _, tb := range o.TagBindings {
+		if tb == nil || tb.Key == "" || len(tb.Key) > 128 {
+			return ErrInvalidTagBindingKey
+		}
+		if len(tb.Value) > 256 {
+			return ErrInvalidTagBindingValue
+		}
+	}
+
+	return nil
+}
+
+// buildQueryString returns the tag binding filters of the options, which
+// cannot be encoded by the query string library as they are indexed.
+func (o *ProjectListOptions) buildQueryString() map[string][]string {
+	if o == nil || len(o.TagBindings) == 0 {
+		return nil
+	}
+
+	result := make(map[string][]string)
+	for i, tb := range o.TagBindings {
+		result[fmt.Sprintf("filter[tagged][%d][key]", i)] = []string{tb.Key}
+		if tb.Value != "" {
+			result[fmt.Sprintf("filter[tagged][%d][value]", i)] = []string{tb.Value}
+		}
+	}
+
+	return result
+}
+
+func (o *ProjectReadOptions) valid() error {
+	return nil
+}
+
 func (o ProjectCreateOptions) valid() error {
 	if !validString(&o.Name) {
 		return ErrRequiredName
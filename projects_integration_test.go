@@ -4,7 +4,12 @@
 package tfe
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -48,6 +53,49 @@ func TestProjectsList(t *testing.T) {
 		assert.Equal(t, 3, len(pl.Items))
 	})
 
+	t.Run("with a name query", func(t *testing.T) {
+		pl, err := client.Projects.List(ctx, orgTest.Name, &ProjectListOptions{
+			Query: pTest1.Name,
+		})
+		require.NoError(t, err)
+		assert.Contains(t, pl.Items, pTest1)
+		assert.NotContains(t, pl.Items, pTest2)
+	})
+
+	t.Run("with tag binding filters", func(t *testing.T) {
+		_, err := client.Projects.Update(ctx, pTest1.ID, ProjectUpdateOptions{
+			TagBindings: []*TagBinding{
+				{Key: "env", Value: "prod"},
+				{Key: "team", Value: "platform"},
+			},
+		})
+		require.NoError(t, err)
+
+		pl, err := client.Projects.List(ctx, orgTest.Name, &ProjectListOptions{
+			TagBindings: []*TagBinding{
+				{Key: "env", Value: "prod"},
+				{Key: "team"},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, pl.Items, 1)
+		assert.Equal(t, pTest1.ID, pl.Items[0].ID)
+
+		pl, err = client.Projects.List(ctx, orgTest.Name, &ProjectListOptions{
+			TagBindings: []*TagBinding{{Key: "env", Value: "dev"}},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, pl.Items)
+	})
+
+	t.Run("with invalid tag binding filters", func(t *testing.T) {
+		pl, err := client.Projects.List(ctx, orgTest.Name, &ProjectListOptions{
+			TagBindings: []*TagBinding{{Value: "prod"}},
+		})
+		assert.Nil(t, pl)
+		assert.Equal(t, err, ErrInvalidTagBindingKey)
+	})
+
 	t.Run("without a valid organization", func(t *testing.T) {
 		pl, err := client.Projects.List(ctx, badIdentifier, nil)
 		assert.Nil(t, pl)
@@ -55,6 +103,24 @@ func TestProjectsList(t *testing.T) {
 	})
 }
 
+func TestProjectListOptions_buildQueryString(t *testing.T) {
+	options := &ProjectListOptions{
+		TagBindings: []*TagBinding{
+			{Key: "env", Value: "prod"},
+			{Key: "team"},
+		},
+	}
+
+	assert.Equal(t, map[string][]string{
+		"filter[tagged][0][key]":   {"env"},
+		"filter[tagged][0][value]": {"prod"},
+		"filter[tagged][1][key]":   {"team"},
+	}, options.buildQueryString())
+
+	var nilOptions *ProjectListOptions
+	assert.Nil(t, nilOptions.buildQueryString())
+}
+
 func TestProjectsRead(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -85,6 +151,142 @@ func TestProjectsRead(t *testing.T) {
 	})
 }
 
+func TestProjectsReadWithOptions(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	pTest, err := client.Projects.Create(ctx, orgTest.Name, ProjectCreateOptions{
+		Name:        randomString(t),
+		TagBindings: []*TagBinding{{Key: "env", Value: "prod"}},
+	})
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, client.Projects.Delete(ctx, pTest.ID))
+	}()
+
+	_, wTestCleanup := createWorkspaceWithOptions(t, client, orgTest, WorkspaceCreateOptions{
+		Name:    String(randomString(t)),
+		Project: pTest,
+	})
+	defer wTestCleanup()
+
+	t.Run("with the effective tag bindings included", func(t *testing.T) {
+		p, err := client.Projects.ReadWithOptions(ctx, pTest.ID, &ProjectReadOptions{
+			Include: []ProjectIncludeOpt{ProjectEffectiveTagBindings},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, p.WorkspaceCount)
+		require.Len(t, p.EffectiveTagBindings, 1)
+		assert.Equal(t, "env", p.EffectiveTagBindings[0].Key)
+		assert.Equal(t, "prod", p.EffectiveTagBindings[0].Value)
+	})
+
+	t.Run("without a valid project ID", func(t *testing.T) {
+		p, err := client.Projects.ReadWithOptions(ctx, badIdentifier, nil)
+		assert.Nil(t, p)
+		assert.EqualError(t, err, ErrInvalidProjectID.Error())
+	})
+}
+
+func TestProjectsReadHealthSummary(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	pTest, pTestCleanup := createProject(t, client, orgTest)
+	defer pTestCleanup()
+
+	wTest, wTestCleanup := createWorkspaceWithOptions(t, client, orgTest, WorkspaceCreateOptions{
+		Name:    String(randomString(t)),
+		Project: pTest,
+	})
+	defer wTestCleanup()
+
+	_, wEmptyCleanup := createWorkspaceWithOptions(t, client, orgTest, WorkspaceCreateOptions{
+		Name:    String(randomString(t)),
+		Project: pTest,
+	})
+	defer wEmptyCleanup()
+
+	rTest, _ := createPlannedRun(t, client, wTest)
+
+	t.Run("with workspaces in the project", func(t *testing.T) {
+		summary, err := client.Projects.ReadHealthSummary(ctx, pTest.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, pTest.ID, summary.ProjectID)
+		assert.Equal(t, 2, summary.WorkspaceCount)
+		assert.Equal(t, 1, summary.WithoutRuns)
+		assert.Equal(t, map[RunStatus]int{rTest.Status: 1}, summary.RunStatuses)
+	})
+
+	t.Run("without a valid project ID", func(t *testing.T) {
+		summary, err := client.Projects.ReadHealthSummary(ctx, badIdentifier)
+		assert.Nil(t, summary)
+		assert.EqualError(t, err, ErrInvalidProjectID.Error())
+	})
+}
+
+func TestProjectsReadHealthSummary_Pagination(t *testing.T) {
+	ctx := context.Background()
+
+	var queries []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(204)
+		case "/api/v2/projects/prj-1":
+			w.Write([]byte(`{"data":{"type":"projects","id":"prj-1","attributes":{"name":"prod","workspace-count":3},
+				"relationships":{"organization":{"data":{"type":"organizations","id":"my-org"}}}}}`))
+		case "/api/v2/organizations/my-org/workspaces":
+			queries = append(queries, r.URL.RawQuery)
+
+			page, next, status := 1, 2, "applied"
+			if r.URL.Query().Get("page[number]") == "2" {
+				page, next, status = 2, 0, "errored"
+			}
+			fmt.Fprintf(w, `{"data":[
+				{"type":"workspaces","id":"ws-%[1]d","relationships":{"current-run":{"data":{"type":"runs","id":"run-%[1]d"}}}},
+				{"type":"workspaces","id":"ws-%[1]d-new","relationships":{"current-run":{"data":null}}}],
+				"included":[{"type":"runs","id":"run-%[1]d","attributes":{"status":%[3]q}}],
+				"meta":{"pagination":{"current-page":%[1]d,"next-page":%[2]d}}}`, page, next, status)
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "abcd1234",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	summary, err := client.Projects.ReadHealthSummary(ctx, "prj-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, &ProjectHealthSummary{
+		ProjectID:      "prj-1",
+		WorkspaceCount: 4,
+		WithoutRuns:    2,
+		RunStatuses:    map[RunStatus]int{RunApplied: 1, RunErrored: 1},
+	}, summary)
+
+	require.Len(t, queries, 2)
+	for _, q := range queries {
+		assert.Contains(t, q, "filter%5Bproject%5D%5Bid%5D=prj-1")
+		assert.Contains(t, q, "include=current_run")
+	}
+}
+
 func TestProjectsCreate(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -201,3 +403,117 @@ func TestProjectsDelete(t *testing.T) {
 		assert.EqualError(t, err, ErrInvalidProjectID.Error())
 	})
 }
+
+func TestProjectsListEffectiveTagBindings(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	pTest, err := client.Projects.Create(ctx, orgTest.Name, ProjectCreateOptions{
+		Name: randomStringWithoutSpecialChar(t),
+		TagBindings: []*TagBinding{
+			{Key: "cost-center", Value: "platform"},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := client.Projects.Delete(ctx, pTest.ID); err != nil {
+			t.Logf("Error destroying project! WARNING: Dangling resources "+
+				"may exist! The full error is shown below.\n\n"+
+				"Project ID: %s\nError: %s", pTest.ID, err)
+		}
+	})
+
+	t.Run("lists the tag bindings of the project", func(t *testing.T) {
+		bindings, err := client.Projects.ListTagBindings(ctx, pTest.ID)
+		require.NoError(t, err)
+		require.Len(t, bindings, 1)
+		assert.Equal(t, "cost-center", bindings[0].Key)
+		assert.Equal(t, "platform", bindings[0].Value)
+	})
+
+	t.Run("lists the effective tag bindings of the project", func(t *testing.T) {
+		bindings, err := client.Projects.ListEffectiveTagBindings(ctx, pTest.ID)
+		require.NoError(t, err)
+		require.Len(t, bindings, 1)
+		assert.Equal(t, "cost-center", bindings[0].Key)
+		assert.Equal(t, "platform", bindings[0].Value)
+		assert.Equal(t, TagBindingSourceProject, bindings[0].Source)
+	})
+
+	t.Run("lists the tag bindings inherited by a workspace", func(t *testing.T) {
+		wTest, wTestCleanup := createWorkspaceWithOptions(t, client, orgTest, WorkspaceCreateOptions{
+			Name:    String(randomString(t)),
+			Project: pTest,
+		})
+		defer wTestCleanup()
+
+		bindings, err := client.Workspaces.ListEffectiveTagBindings(ctx, wTest.ID)
+		require.NoError(t, err)
+		require.Len(t, bindings, 1)
+		assert.Equal(t, "cost-center", bindings[0].Key)
+		assert.Equal(t, TagBindingSourceProject, bindings[0].Source)
+	})
+
+	t.Run("without a valid project ID", func(t *testing.T) {
+		bindings, err := client.Projects.ListEffectiveTagBindings(ctx, badIdentifier)
+		assert.Nil(t, bindings)
+		assert.EqualError(t, err, ErrInvalidProjectID.Error())
+	})
+}
+
+func TestEffectiveTagBindings_Unmarshal(t *testing.T) {
+	data := map[string]interface{}{
+		"data": []map[string]interface{}{
+			{
+				"type": "effective-tag-bindings",
+				"id":   "etb-1",
+				"attributes": map[string]interface{}{
+					"key":   "env",
+					"value": "prod",
+				},
+			},
+			{
+				"type": "effective-tag-bindings",
+				"id":   "etb-2",
+				"attributes": map[string]interface{}{
+					"key":   "cost-center",
+					"value": "platform",
+				},
+				"links": map[string]interface{}{
+					"inherited-from": "/api/v2/projects/prj-1",
+				},
+			},
+			{
+				"type": "effective-tag-bindings",
+				"id":   "etb-3",
+				"attributes": map[string]interface{}{
+					"key": "owner",
+				},
+				"links": map[string]interface{}{
+					"inherited-from": "/api/v2/organizations/my-org",
+				},
+			},
+		},
+	}
+
+	byteData, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	etbl := &effectiveTagBindingList{}
+	err = unmarshalResponse(bytes.NewReader(byteData), etbl)
+	require.NoError(t, err)
+	etbl.setSources(TagBindingSourceWorkspace)
+
+	require.Len(t, etbl.Items, 3)
+	assert.Equal(t, "env", etbl.Items[0].Key)
+	assert.Equal(t, "prod", etbl.Items[0].Value)
+	assert.Equal(t, TagBindingSourceWorkspace, etbl.Items[0].Source)
+	assert.Equal(t, "cost-center", etbl.Items[1].Key)
+	assert.Equal(t, TagBindingSourceProject, etbl.Items[1].Source)
+	assert.Equal(t, "owner", etbl.Items[2].Key)
+	assert.Empty(t, etbl.Items[2].Value)
+	assert.Equal(t, TagBindingSourceOrganization, etbl.Items[2].Source)
+}
@@ -63,6 +63,11 @@ type RegistryModules interface {
 
 	// Upload a tar gzip archive to the specified configuration version upload URL.
 	UploadTarGzip(ctx context.Context, url string, r io.Reader) error
+
+	// ListConsumingWorkspaces lists the workspaces whose configuration uses
+	// a registry module. The API does not support this, so it always returns
+	// an error wrapping ErrNotSupported.
+	ListConsumingWorkspaces(ctx context.Context, moduleID RegistryModuleID) ([]*Workspace, error)
 }
 
 // registryModules implements RegistryModules.
@@ -377,6 +382,18 @@ func (r *registryModules) UploadTarGzip(ctx context.Context, uploadURL string, a
 	return r.client.doForeignPUTRequest(ctx, uploadURL, archive)
 }
 
+// ListConsumingWorkspaces would list the workspaces using a registry module,
+// but the API doesn't expose module usage. To find them, download the current
+// configuration version of each workspace with ConfigurationVersions.Download
+// and look for module blocks whose source is the module.
+func (r *registryModules) ListConsumingWorkspaces(ctx context.Context, moduleID RegistryModuleID) ([]*Workspace, error) {
+	if err := moduleID.valid(); err != nil {
+		return nil, err
+	}
+
+	return nil, ErrModuleUsageNotSupported
+}
+
 // Create a new registry module without a VCS repo
 func (r *registryModules) Create(ctx context.Context, organization string, options RegistryModuleCreateOptions) (*RegistryModule, error) {
 	if !validStringID(&organization) {
@@ -1661,6 +1661,33 @@ func TestRegistryModulesUploadTarGzip(t *testing.T) {
 	})
 }
 
+func TestRegistryModulesListConsumingWorkspaces(t *testing.T) {
+	client := &Client{}
+	client.RegistryModules = &registryModules{client: client}
+	ctx := context.Background()
+
+	t.Run("with a valid module ID", func(t *testing.T) {
+		ws, err := client.RegistryModules.ListConsumingWorkspaces(ctx, RegistryModuleID{
+			Organization: "my-org",
+			Name:         "vpc",
+			Provider:     "aws",
+			Namespace:    "my-org",
+			RegistryName: PrivateRegistry,
+		})
+		assert.Nil(t, ws)
+		assert.ErrorIs(t, err, ErrNotSupported)
+		assert.Equal(t, ErrModuleUsageNotSupported, err)
+	})
+
+	t.Run("with an invalid module ID", func(t *testing.T) {
+		_, err := client.RegistryModules.ListConsumingWorkspaces(ctx, RegistryModuleID{
+			Organization: "my-org",
+			Provider:     "aws",
+		})
+		assert.Equal(t, ErrRequiredName, err)
+	})
+}
+
 func TestRegistryModule_Unmarshal(t *testing.T) {
 	data := map[string]interface{}{
 		"data": map[string]interface{}{
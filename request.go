@@ -20,6 +20,10 @@ type ClientRequest struct {
 	http             *retryablehttp.Client
 	limiter          *rate.Limiter
 
+	// errorsPayload makes unsuccessful responses holding JSON:API errors
+	// return an *ErrorsPayload.
+	errorsPayload bool
+
 	// Header are the headers that will be sent in this request
 	Header http.Header
 }
@@ -60,7 +64,7 @@ func (r ClientRequest) Do(ctx context.Context, model interface{}) error {
 	defer resp.Body.Close()
 
 	// Basic response checking.
-	if err := checkResponseCode(resp); err != nil {
+	if err := r.checkResponseCode(resp); err != nil {
 		return err
 	}
 
@@ -78,6 +82,80 @@ func (r ClientRequest) Do(ctx context.Context, model interface{}) error {
 	return unmarshalResponse(resp.Body, model)
 }
 
+// checkResponseCode checks the status code of resp, keeping the JSON:API
+// errors of the response when the request asks for them.
+func (r ClientRequest) checkResponseCode(resp *http.Response) error {
+	if r.errorsPayload {
+		return checkResponseCodeWithErrorsPayload(resp)
+	}
+	return checkResponseCode(resp)
+}
+
+// doRedirect is similar to Do except that a redirect response is not followed.
+// It returns the location of the redirect, or ErrNoRedirect when the response
+// is not a redirect.
+func (r ClientRequest) doRedirect(ctx context.Context) (string, error) {
+	if r.limiter != nil {
+		if err := r.limiter.Wait(ctx); err != nil {
+			return "", err
+		}
+	}
+
+	respHeaderHook := contextResponseHeaderHook(ctx)
+
+	// Use a copy of the HTTP client that stops at the first response.
+	httpClient := http.Client{}
+	if r.http.HTTPClient != nil {
+		httpClient = *r.http.HTTPClient
+	}
+	httpClient.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	client := &retryablehttp.Client{
+		HTTPClient:      &httpClient,
+		Logger:          r.http.Logger,
+		RetryWaitMin:    r.http.RetryWaitMin,
+		RetryWaitMax:    r.http.RetryWaitMax,
+		RetryMax:        r.http.RetryMax,
+		RequestLogHook:  r.http.RequestLogHook,
+		ResponseLogHook: r.http.ResponseLogHook,
+		CheckRetry:      r.http.CheckRetry,
+		Backoff:         r.http.Backoff,
+		ErrorHandler:    r.http.ErrorHandler,
+	}
+
+	resp, err := client.Do(r.retryableRequest.WithContext(ctx))
+	if resp != nil {
+		respHeaderHook(resp.StatusCode, resp.Header)
+	}
+	if err != nil {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		default:
+			return "", err
+		}
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther,
+		http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		location, err := resp.Location()
+		if err != nil {
+			return "", err
+		}
+		return location.String(), nil
+	}
+
+	if err := checkResponseCode(resp); err != nil {
+		return "", err
+	}
+
+	return "", ErrNoRedirect
+}
+
 // DoJSON is similar to Do except that it should be used when a plain JSON response is expected
 // as opposed to json-api.
 func (r *ClientRequest) DoJSON(ctx context.Context, model any) error {
@@ -44,6 +44,28 @@ func ContextWithResponseHeaderHook(parentCtx context.Context, cb func(status int
 	return context.WithValue(parentCtx, contextResponseHeaderHookKey, finalCb)
 }
 
+// ContextWithETag returns a context that, if passed to a read method such as
+// Workspaces.ReadByID or Runs.Read, stores the ETag header of the response in
+// etag. The ETag can then be sent back with the IfNoneMatch option of the
+// read, to only get the resource again when it has changed.
+//
+// etag is left untouched when the response has no ETag header.
+func ContextWithETag(ctx context.Context, etag *string) context.Context {
+	return ContextWithResponseHeaderHook(ctx, func(_ int, header http.Header) {
+		if e := header.Get("ETag"); e != "" {
+			*etag = e
+		}
+	})
+}
+
+// contextWithStatusCode returns a context that stores the status code of the
+// response to a request made with it in status.
+func contextWithStatusCode(ctx context.Context, status *int) context.Context {
+	return ContextWithResponseHeaderHook(ctx, func(code int, _ http.Header) {
+		*status = code
+	})
+}
+
 func contextResponseHeaderHook(ctx context.Context) func(int, http.Header) {
 	cbI := ctx.Value(contextResponseHeaderHookKey)
 	if cbI == nil {
@@ -4,10 +4,16 @@
 package tfe
 
 import (
+	"bufio"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/url"
+	"strings"
 	"time"
+
+	"github.com/hashicorp/go-uuid"
 )
 
 // Compile-time proof of interface implementation.
@@ -24,6 +30,22 @@ type Runs interface {
 	// Create a new run with the given options.
 	Create(ctx context.Context, options RunCreateOptions) (*Run, error)
 
+	// CreateDestroy creates a new destroy run, making sure the workspace
+	// allows destroy plans first.
+	CreateDestroy(ctx context.Context, options RunCreateDestroyOptions) (*Run, error)
+
+	// CreateSpeculativeFromVCS creates a plan-only run on a VCS-backed
+	// workspace from the configuration of a given branch or commit.
+	CreateSpeculativeFromVCS(ctx context.Context, workspaceID string, options RunCreateSpeculativeFromVCSOptions) (*Run, error)
+
+	// Retry creates a new run that repeats an errored run, using the same
+	// workspace and configuration version.
+	Retry(ctx context.Context, runID string, options RunRetryOptions) (*Run, error)
+
+	// ExportSentinelMockData exports the Sentinel mock data of a run's plan
+	// and waits for the export to finish.
+	ExportSentinelMockData(ctx context.Context, runID string, options *WaitOptions) (*PlanExport, error)
+
 	// Read a run by its ID.
 	Read(ctx context.Context, runID string) (*Run, error)
 
@@ -44,6 +66,10 @@ type Runs interface {
 
 	// Discard a run by its ID.
 	Discard(ctx context.Context, runID string, options RunDiscardOptions) error
+
+	// StreamLogs streams the plan logs and then the apply logs of a run, one
+	// line at a time, until the run is done.
+	StreamLogs(ctx context.Context, runID string) (<-chan LogLineOrError, error)
 }
 
 // runs implements Runs.
@@ -88,6 +114,23 @@ const (
 	RunQueuingApply             RunStatus = "queuing_apply"
 )
 
+// RunLogPhase represents the phase of a run that logged a line.
+type RunLogPhase string
+
+// List all available run log phases.
+const (
+	RunLogPlan  RunLogPhase = "plan"
+	RunLogApply RunLogPhase = "apply"
+)
+
+// LogLineOrError is a line of the logs streamed by Runs.StreamLogs, or the
+// error that stopped the stream. The trailing newline is not part of Line.
+type LogLineOrError struct {
+	Phase RunLogPhase
+	Line  string
+	Err   error
+}
+
 // RunSource represents a source type of a run.
 type RunSource string
 
@@ -155,6 +198,13 @@ type Run struct {
 	Comments             []*Comment            `jsonapi:"relation,comments"`
 }
 
+// IsApplyable reports whether the run is waiting for confirmation and applying
+// it would change something. Refresh-only runs that detected drift have
+// changes even though they don't change any resources.
+func (r *Run) IsApplyable() bool {
+	return r.Actions != nil && r.Actions.IsConfirmable && r.HasChanges
+}
+
 // RunActions represents the run actions.
 type RunActions struct {
 	IsCancelable      bool `jsonapi:"attr,is-cancelable"`
@@ -214,8 +264,15 @@ const (
 	RunConfigVerIngress RunIncludeOpt = "configuration_version.ingress_attributes"
 	RunWorkspace        RunIncludeOpt = "workspace"
 	RunTaskStages       RunIncludeOpt = "task_stages"
+	RunTaskResults      RunIncludeOpt = "task_stages.task_results"
+	RunPolicyChecks     RunIncludeOpt = "policy_checks"
+	RunPlanExports      RunIncludeOpt = "plan.exports"
 )
 
+// maxRunReadIncludes is the maximum number of relations that can be included
+// when reading a run, which keeps the request URL reasonably short.
+const maxRunReadIncludes = 8
+
 // RunListOptions represents the options for listing runs.
 type RunListOptions struct {
 	ListOptions
@@ -255,6 +312,11 @@ type RunReadOptions struct {
 	// Optional: A list of relations to include. See available resources:
 	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/run#available-related-resources
 	Include []RunIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The ETag of a previous read of the run, as captured with
+	// ContextWithETag. When the run has not changed since, ErrNotModified is
+	// returned instead.
+	IfNoneMatch string `url:"-"`
 }
 
 // RunCreateOptions represents the options for creating a new run.
@@ -335,6 +397,51 @@ type RunCreateOptions struct {
 	// Variables allows you to specify terraform input variables for
 	// a particular run, prioritized over variables defined on the workspace.
 	Variables []*RunVariable `jsonapi:"attr,variables,omitempty"`
+
+	// IdempotencyKey is sent in the Idempotency-Key header so that a retried
+	// request does not create a second run. When nil, a random UUID is used
+	// for the request; set it to correlate the request with its run. Servers
+	// that do not support the header ignore it, in which case a retried
+	// request may still create a duplicate run.
+	IdempotencyKey *string
+}
+
+// RunCreateDestroyOptions represents the options for creating a destroy run.
+type RunCreateDestroyOptions struct {
+	RunCreateOptions
+
+	// ForceAllowDestroy enables destroy plans on the workspace when they are
+	// disabled, instead of returning ErrWorkspaceDestroyPlanNotAllowed.
+	ForceAllowDestroy bool
+}
+
+// RunCreateSpeculativeFromVCSOptions represents the options for creating a
+// speculative run from a workspace's VCS connection.
+type RunCreateSpeculativeFromVCSOptions struct {
+	// Optional: The branch to plan. The run uses the most recent configuration
+	// version ingressed from this branch.
+	Branch *string
+
+	// Optional: The commit to plan, either a full or abbreviated SHA. Takes
+	// precedence over Branch.
+	CommitSHA *string
+
+	// Optional: A message about the run.
+	Message *string
+
+	// Optional: Run-specific variable values.
+	Variables []*RunVariable
+}
+
+// RunRetryOptions represents the options for retrying an errored run.
+type RunRetryOptions struct {
+	// Optional: The message of the new run. Defaults to the message of the
+	// errored run, prefixed with "retry of" and its ID.
+	Message *string
+
+	// Optional: The idempotency key of the new run. See
+	// RunCreateOptions.IdempotencyKey.
+	IdempotencyKey *string
 }
 
 // RunApplyOptions represents the options for applying a run.
@@ -404,10 +511,22 @@ func (s *runs) Create(ctx context.Context, options RunCreateOptions) (*Run, erro
 		return nil, err
 	}
 
+	idempotencyKey := ""
+	if options.IdempotencyKey != nil {
+		idempotencyKey = *options.IdempotencyKey
+	} else {
+		key, err := uuid.GenerateUUID()
+		if err != nil {
+			return nil, err
+		}
+		idempotencyKey = key
+	}
+
 	req, err := s.client.NewRequest("POST", "runs", &options)
 	if err != nil {
 		return nil, err
 	}
+	req.Header.Set("Idempotency-Key", idempotencyKey)
 
 	r := &Run{}
 	err = req.Do(ctx, r)
@@ -418,6 +537,206 @@ func (s *runs) Create(ctx context.Context, options RunCreateOptions) (*Run, erro
 	return r, nil
 }
 
+// CreateDestroy creates a new destroy run. Workspaces that do not allow
+// destroy plans reject such runs, so the workspace is checked first and,
+// when ForceAllowDestroy is set, updated to allow them.
+func (s *runs) CreateDestroy(ctx context.Context, options RunCreateDestroyOptions) (*Run, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+	if !validStringID(&options.Workspace.ID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	w, err := s.client.Workspaces.ReadByID(ctx, options.Workspace.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !w.AllowDestroyPlan {
+		if !options.ForceAllowDestroy {
+			return nil, ErrWorkspaceDestroyPlanNotAllowed
+		}
+
+		_, err = s.client.Workspaces.UpdateByID(ctx, w.ID, WorkspaceUpdateOptions{
+			AllowDestroyPlan: Bool(true),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	createOptions := options.RunCreateOptions
+	createOptions.IsDestroy = Bool(true)
+
+	return s.Create(ctx, createOptions)
+}
+
+// CreateSpeculativeFromVCS creates a plan-only run on a VCS-backed workspace.
+// Without a branch or commit the workspace's current configuration is used,
+// otherwise the configuration versions of the workspace are searched for the
+// most recent one ingressed from that ref. Terraform Cloud only ingresses
+// configuration for its tracked branch and pull requests, so
+// ErrVCSRefNotFound is returned for refs it has not seen.
+func (s *runs) CreateSpeculativeFromVCS(ctx context.Context, workspaceID string, options RunCreateSpeculativeFromVCSOptions) (*Run, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	w, err := s.client.Workspaces.ReadByID(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if w.VCSRepo == nil {
+		return nil, ErrWorkspaceNotVCSBacked
+	}
+
+	var cv *ConfigurationVersion
+	if options.Branch != nil || options.CommitSHA != nil {
+		cv, err = s.findVCSConfigurationVersion(ctx, workspaceID, options)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.Create(ctx, RunCreateOptions{
+		Workspace:            w,
+		ConfigurationVersion: cv,
+		Message:              options.Message,
+		PlanOnly:             Bool(true),
+		Variables:            options.Variables,
+	})
+}
+
+// findVCSConfigurationVersion returns the most recent uploaded configuration
+// version of a workspace matching the branch or commit of options.
+func (s *runs) findVCSConfigurationVersion(ctx context.Context, workspaceID string, options RunCreateSpeculativeFromVCSOptions) (*ConfigurationVersion, error) {
+	listOptions := &ConfigurationVersionListOptions{
+		Include: []ConfigVerIncludeOpt{ConfigVerIngressAttributes},
+	}
+	for {
+		cvl, err := s.client.ConfigurationVersions.List(ctx, workspaceID, listOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cv := range cvl.Items {
+			ia := cv.IngressAttributes
+			if cv.Status != ConfigurationUploaded || ia == nil {
+				continue
+			}
+
+			switch {
+			case options.CommitSHA != nil:
+				if *options.CommitSHA != "" && strings.HasPrefix(ia.CommitSHA, *options.CommitSHA) {
+					return cv, nil
+				}
+			case ia.Branch == *options.Branch:
+				return cv, nil
+			}
+		}
+
+		if cvl.Pagination == nil || cvl.NextPage == 0 {
+			break
+		}
+		listOptions.PageNumber = cvl.NextPage
+	}
+
+	return nil, ErrVCSRefNotFound
+}
+
+// Retry creates a new run from an errored run. The new run uses the same
+// workspace, configuration version, variables and planning options.
+func (s *runs) Retry(ctx context.Context, runID string, options RunRetryOptions) (*Run, error) {
+	if !validStringID(&runID) {
+		return nil, ErrInvalidRunID
+	}
+
+	r, err := s.Read(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Status != RunErrored {
+		return nil, ErrRunNotErrored
+	}
+
+	message := options.Message
+	if message == nil {
+		m := fmt.Sprintf("retry of %s", r.ID)
+		if r.Message != "" {
+			m = fmt.Sprintf("%s: %s", m, r.Message)
+		}
+		message = &m
+	}
+
+	var variables []*RunVariable
+	for _, v := range r.Variables {
+		variables = append(variables, &RunVariable{Key: v.Key, Value: v.Value})
+	}
+
+	return s.Create(ctx, RunCreateOptions{
+		Workspace:            r.Workspace,
+		ConfigurationVersion: r.ConfigurationVersion,
+		Message:              message,
+		AllowEmptyApply:      Bool(r.AllowEmptyApply),
+		AutoApply:            Bool(r.AutoApply),
+		IsDestroy:            Bool(r.IsDestroy),
+		PlanOnly:             Bool(r.PlanOnly),
+		Refresh:              Bool(r.Refresh),
+		RefreshOnly:          Bool(r.RefreshOnly),
+		TargetAddrs:          r.TargetAddrs,
+		ReplaceAddrs:         r.ReplaceAddrs,
+		Variables:            variables,
+		IdempotencyKey:       options.IdempotencyKey,
+	})
+}
+
+// ExportSentinelMockData creates a sentinel-mock-bundle-v0 export of the
+// plan of a run and polls it until it is finished, so the mock data can be
+// downloaded with PlanExports.Download and used with `sentinel test`. The
+// export is polled every 500 milliseconds unless options sets a poll interval
+// or backoff, and ErrWaitTimeout is returned when options.MaxWait is exceeded.
+func (s *runs) ExportSentinelMockData(ctx context.Context, runID string, options *WaitOptions) (*PlanExport, error) {
+	if !validStringID(&runID) {
+		return nil, ErrInvalidRunID
+	}
+
+	r, err := s.Read(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Plan == nil {
+		return nil, ErrRunHasNoPlan
+	}
+
+	pe, err := s.client.PlanExports.Create(ctx, PlanExportCreateOptions{
+		Plan:     r.Plan,
+		DataType: PlanExportType(PlanExportSentinelMockBundleV0),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = wait(ctx, options, func() (bool, error) {
+		if done, err := pe.done(); done || err != nil {
+			return done, err
+		}
+
+		pe, err = s.client.PlanExports.Read(ctx, pe.ID)
+		if err != nil {
+			return false, err
+		}
+		return pe.done()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pe, nil
+}
+
 // Read a run by its ID.
 func (s *runs) Read(ctx context.Context, runID string) (*Run, error) {
 	return s.ReadWithOptions(ctx, runID, nil)
@@ -437,6 +756,9 @@ func (s *runs) ReadWithOptions(ctx context.Context, runID string, options *RunRe
 	if err != nil {
 		return nil, err
 	}
+	if options != nil && options.IfNoneMatch != "" {
+		req.Header.Set("If-None-Match", options.IfNoneMatch)
+	}
 
 	r := &Run{}
 	err = req.Do(ctx, r)
@@ -527,6 +849,117 @@ func (s *runs) Discard(ctx context.Context, runID string, options RunDiscardOpti
 	return req.Do(ctx, nil)
 }
 
+// StreamLogs tails the logs of the plan of a run until the plan is done, then
+// waits for the apply to start and tails its logs. The returned channel is
+// closed once the run is done, after sending an error if streaming failed.
+// Canceling the context stops the stream.
+func (s *runs) StreamLogs(ctx context.Context, runID string) (<-chan LogLineOrError, error) {
+	if !validStringID(&runID) {
+		return nil, ErrInvalidRunID
+	}
+
+	r, err := s.Read(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Plan == nil {
+		return nil, ErrRunHasNoPlan
+	}
+
+	lines := make(chan LogLineOrError)
+	send := func(line LogLineOrError) bool {
+		select {
+		case lines <- line:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	go func() {
+		defer close(lines)
+		if err := s.streamLogs(ctx, r, send); err != nil {
+			send(LogLineOrError{Err: err})
+		}
+	}()
+
+	return lines, nil
+}
+
+func (s *runs) streamLogs(ctx context.Context, r *Run, send func(LogLineOrError) bool) error {
+	logs, err := s.client.Plans.Logs(ctx, r.Plan.ID)
+	if err != nil {
+		return err
+	}
+	if err := sendLogLines(ctx, logs, RunLogPlan, send); err != nil {
+		return err
+	}
+
+	// Wait for the apply to start, or for the run to end without one.
+	var ended bool
+	err = wait(ctx, nil, func() (bool, error) {
+		r, err = s.Read(ctx, r.ID)
+		if err != nil {
+			return false, err
+		}
+
+		switch r.Status {
+		case RunApplyQueued, RunApplying:
+			return true, nil
+		case RunApplied, RunCanceled, RunDiscarded, RunErrored, RunPlannedAndFinished, RunPlannedAndSaved:
+			ended = true
+			return true, nil
+		default:
+			return false, nil
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if r.Apply == nil {
+		return nil
+	}
+
+	if ended {
+		a, err := s.client.Applies.Read(ctx, r.Apply.ID)
+		if err != nil {
+			return err
+		}
+		if a.Status == ApplyPending || a.Status == ApplyUnreachable || a.LogReadURL == "" {
+			return nil
+		}
+	}
+
+	logs, err = s.client.Applies.Logs(ctx, r.Apply.ID)
+	if err != nil {
+		return err
+	}
+	return sendLogLines(ctx, logs, RunLogApply, send)
+}
+
+// sendLogLines reads logs line by line and sends every line until the logs
+// end or the context is canceled.
+func sendLogLines(ctx context.Context, logs io.Reader, phase RunLogPhase, send func(LogLineOrError) bool) error {
+	br := bufio.NewReader(logs)
+	for {
+		line, err := br.ReadString('\n')
+		if line != "" {
+			line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+			if !send(LogLineOrError{Phase: phase, Line: line}) {
+				return ctx.Err()
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
 func (o RunCreateOptions) valid() error {
 	if o.Workspace == nil {
 		return ErrRequiredWorkspace
@@ -536,10 +969,22 @@ func (o RunCreateOptions) valid() error {
 		return ErrTerraformVersionValidForPlanOnly
 	}
 
+	if o.IdempotencyKey != nil && !validString(o.IdempotencyKey) {
+		return ErrInvalidIdempotencyKey
+	}
+
 	return nil
 }
 
 func (o *RunReadOptions) valid() error {
+	if o == nil {
+		return nil // nothing to validate
+	}
+
+	if len(o.Include) > maxRunReadIncludes {
+		return fmt.Errorf("%w: at most %d relations can be included", ErrInvalidIncludeValue, maxRunReadIncludes)
+	}
+
 	return nil
 }
 
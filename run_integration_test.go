@@ -8,6 +8,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"strconv"
 	"testing"
 	"time"
 
@@ -318,6 +324,11 @@ func TestRunsCreate(t *testing.T) {
 		assert.Equal(t, options.ReplaceAddrs, r.ReplaceAddrs)
 		assert.Equal(t, options.TargetAddrs, r.TargetAddrs)
 		assert.Nil(t, r.Variables)
+
+		r, err = client.Runs.Read(ctx, r.ID)
+		require.NoError(t, err)
+		assert.Equal(t, options.ReplaceAddrs, r.ReplaceAddrs)
+		assert.Equal(t, options.TargetAddrs, r.TargetAddrs)
 	})
 
 	t.Run("with variables", func(t *testing.T) {
@@ -355,6 +366,442 @@ func TestRunsCreate(t *testing.T) {
 	})
 }
 
+func TestRunsCreate_IdempotencyKey(t *testing.T) {
+	ctx := context.Background()
+
+	var keys []string
+	var bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(204)
+		case "/api/v2/runs":
+			body, _ := io.ReadAll(r.Body)
+			keys = append(keys, r.Header.Get("Idempotency-Key"))
+			bodies = append(bodies, string(body))
+
+			// Fail the first attempt so the client retries the request.
+			if len(keys)%2 == 1 {
+				w.WriteHeader(503)
+				return
+			}
+			w.Header().Set("Content-Type", ContentTypeJSONAPI)
+			w.WriteHeader(201)
+			w.Write([]byte(`{"data":{"type":"runs","id":"run-1"}}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:           ts.URL,
+		Token:             "abcd1234",
+		HTTPClient:        ts.Client(),
+		RetryServerErrors: true,
+	})
+	require.NoError(t, err)
+
+	t.Run("with a generated idempotency key", func(t *testing.T) {
+		keys, bodies = nil, nil
+
+		r, err := client.Runs.Create(ctx, RunCreateOptions{
+			Workspace: &Workspace{ID: "ws-1"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "run-1", r.ID)
+
+		require.Len(t, keys, 2)
+		assert.NotEmpty(t, keys[0])
+		assert.Equal(t, keys[0], keys[1])
+	})
+
+	t.Run("with a caller provided idempotency key", func(t *testing.T) {
+		keys, bodies = nil, nil
+
+		_, err := client.Runs.Create(ctx, RunCreateOptions{
+			Workspace:      &Workspace{ID: "ws-1"},
+			IdempotencyKey: String("my-key"),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"my-key", "my-key"}, keys)
+		for _, body := range bodies {
+			assert.NotContains(t, body, "my-key")
+		}
+	})
+
+	t.Run("with an empty idempotency key", func(t *testing.T) {
+		_, err := client.Runs.Create(ctx, RunCreateOptions{
+			Workspace:      &Workspace{ID: "ws-1"},
+			IdempotencyKey: String(""),
+		})
+		assert.Equal(t, err, ErrInvalidIdempotencyKey)
+	})
+}
+
+func TestRun_IsApplyable(t *testing.T) {
+	confirmable := &RunActions{IsConfirmable: true}
+
+	assert.True(t, (&Run{Actions: confirmable, HasChanges: true}).IsApplyable())
+	assert.True(t, (&Run{Actions: confirmable, HasChanges: true, RefreshOnly: true}).IsApplyable())
+	assert.False(t, (&Run{Actions: confirmable}).IsApplyable())
+	assert.False(t, (&Run{Actions: &RunActions{}, HasChanges: true}).IsApplyable())
+	assert.False(t, (&Run{HasChanges: true}).IsApplyable())
+}
+
+func TestRunsCreateDestroy(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	wTest, wTestCleanup := createWorkspaceWithOptions(t, client, orgTest, WorkspaceCreateOptions{
+		Name:             String(randomString(t)),
+		AllowDestroyPlan: Bool(false),
+	})
+	defer wTestCleanup()
+
+	_, _ = createUploadedConfigurationVersion(t, client, wTest)
+
+	t.Run("when the workspace does not allow destroy plans", func(t *testing.T) {
+		r, err := client.Runs.CreateDestroy(ctx, RunCreateDestroyOptions{
+			RunCreateOptions: RunCreateOptions{Workspace: wTest},
+		})
+		assert.Nil(t, r)
+		assert.ErrorIs(t, err, ErrWorkspaceDestroyPlanNotAllowed)
+	})
+
+	t.Run("when forcing destroy plans to be allowed", func(t *testing.T) {
+		r, err := client.Runs.CreateDestroy(ctx, RunCreateDestroyOptions{
+			RunCreateOptions:  RunCreateOptions{Workspace: wTest},
+			ForceAllowDestroy: true,
+		})
+		require.NoError(t, err)
+		assert.True(t, r.IsDestroy)
+
+		w, err := client.Workspaces.ReadByID(ctx, wTest.ID)
+		require.NoError(t, err)
+		assert.True(t, w.AllowDestroyPlan)
+	})
+
+	t.Run("without a workspace", func(t *testing.T) {
+		r, err := client.Runs.CreateDestroy(ctx, RunCreateDestroyOptions{})
+		assert.Nil(t, r)
+		assert.Equal(t, err, ErrRequiredWorkspace)
+	})
+}
+
+func TestRunsRetry(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	defer wTestCleanup()
+
+	rTest, rTestCleanup := createRun(t, client, wTest)
+	defer rTestCleanup()
+
+	t.Run("when the run has not errored", func(t *testing.T) {
+		r, err := client.Runs.Retry(ctx, rTest.ID, RunRetryOptions{})
+		assert.Nil(t, r)
+		assert.Equal(t, ErrRunNotErrored, err)
+	})
+
+	t.Run("when the run does not exist", func(t *testing.T) {
+		r, err := client.Runs.Retry(ctx, "nonexisting", RunRetryOptions{})
+		assert.Nil(t, r)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with an invalid run ID", func(t *testing.T) {
+		r, err := client.Runs.Retry(ctx, badIdentifier, RunRetryOptions{})
+		assert.Nil(t, r)
+		assert.EqualError(t, err, ErrInvalidRunID.Error())
+	})
+}
+
+func TestRunsCreateSpeculativeFromVCS(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	defer wTestCleanup()
+
+	t.Run("without a VCS connection", func(t *testing.T) {
+		r, err := client.Runs.CreateSpeculativeFromVCS(ctx, wTest.ID, RunCreateSpeculativeFromVCSOptions{})
+		assert.Nil(t, r)
+		assert.Equal(t, ErrWorkspaceNotVCSBacked, err)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		r, err := client.Runs.CreateSpeculativeFromVCS(ctx, badIdentifier, RunCreateSpeculativeFromVCSOptions{})
+		assert.Nil(t, r)
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+	})
+}
+
+func TestRunsCreateSpeculativeFromVCS_Refs(t *testing.T) {
+	ctx := context.Background()
+
+	var bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(204)
+		case "/api/v2/workspaces/ws-vcs":
+			w.Write([]byte(`{"data":{"type":"workspaces","id":"ws-vcs","attributes":{"vcs-repo":{"identifier":"org/repo"}}}}`))
+		case "/api/v2/workspaces/ws-cli":
+			w.Write([]byte(`{"data":{"type":"workspaces","id":"ws-cli","attributes":{"vcs-repo":null}}}`))
+		case "/api/v2/workspaces/ws-vcs/configuration-versions":
+			if r.URL.Query().Get("page[number]") == "2" {
+				w.Write([]byte(`{"data":[
+					{"type":"configuration-versions","id":"cv-main","attributes":{"status":"uploaded"},
+						"relationships":{"ingress-attributes":{"data":{"type":"ingress-attributes","id":"ia-main"}}}}],
+					"included":[{"type":"ingress-attributes","id":"ia-main","attributes":{"branch":"main","commit-sha":"1111111aaaa"}}],
+					"meta":{"pagination":{"current-page":2,"next-page":null}}}`))
+				return
+			}
+			w.Write([]byte(`{"data":[
+				{"type":"configuration-versions","id":"cv-errored","attributes":{"status":"errored"},
+					"relationships":{"ingress-attributes":{"data":{"type":"ingress-attributes","id":"ia-errored"}}}},
+				{"type":"configuration-versions","id":"cv-feature","attributes":{"status":"uploaded"},
+					"relationships":{"ingress-attributes":{"data":{"type":"ingress-attributes","id":"ia-feature"}}}}],
+				"included":[
+					{"type":"ingress-attributes","id":"ia-errored","attributes":{"branch":"feature","commit-sha":"3333333cccc"}},
+					{"type":"ingress-attributes","id":"ia-feature","attributes":{"branch":"feature","commit-sha":"2222222bbbb"}}],
+				"meta":{"pagination":{"current-page":1,"next-page":2}}}`))
+		case "/api/v2/runs":
+			body, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(body))
+			w.WriteHeader(201)
+			w.Write([]byte(`{"data":{"type":"runs","id":"run-speculative"}}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "abcd1234",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		options RunCreateSpeculativeFromVCSOptions
+		cvID    string
+	}{
+		"with a branch":           {RunCreateSpeculativeFromVCSOptions{Branch: String("feature")}, "cv-feature"},
+		"with a later page":       {RunCreateSpeculativeFromVCSOptions{Branch: String("main")}, "cv-main"},
+		"with an abbreviated sha": {RunCreateSpeculativeFromVCSOptions{CommitSHA: String("1111111")}, "cv-main"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			bodies = nil
+
+			r, err := client.Runs.CreateSpeculativeFromVCS(ctx, "ws-vcs", tc.options)
+			require.NoError(t, err)
+			assert.Equal(t, "run-speculative", r.ID)
+
+			require.Len(t, bodies, 1)
+			assert.Contains(t, bodies[0], `"plan-only":true`)
+			assert.Contains(t, bodies[0], fmt.Sprintf(`"configuration-version":{"data":{"type":"configuration-versions","id":%q}}`, tc.cvID))
+		})
+	}
+
+	t.Run("without a ref", func(t *testing.T) {
+		bodies = nil
+
+		_, err := client.Runs.CreateSpeculativeFromVCS(ctx, "ws-vcs", RunCreateSpeculativeFromVCSOptions{})
+		require.NoError(t, err)
+
+		require.Len(t, bodies, 1)
+		assert.Contains(t, bodies[0], `"plan-only":true`)
+		assert.Contains(t, bodies[0], `"configuration-version":{"data":null}`)
+	})
+
+	t.Run("with an unknown ref", func(t *testing.T) {
+		bodies = nil
+
+		_, err := client.Runs.CreateSpeculativeFromVCS(ctx, "ws-vcs", RunCreateSpeculativeFromVCSOptions{Branch: String("unknown")})
+		assert.Equal(t, ErrVCSRefNotFound, err)
+		assert.Empty(t, bodies)
+	})
+
+	t.Run("without a VCS connection", func(t *testing.T) {
+		_, err := client.Runs.CreateSpeculativeFromVCS(ctx, "ws-cli", RunCreateSpeculativeFromVCSOptions{})
+		assert.Equal(t, ErrWorkspaceNotVCSBacked, err)
+	})
+}
+
+func TestRunsRetry_Errored(t *testing.T) {
+	ctx := context.Background()
+
+	var bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(204)
+		case "/api/v2/runs/run-errored":
+			w.Header().Set("Content-Type", ContentTypeJSONAPI)
+			w.Write([]byte(`{"data":{"type":"runs","id":"run-errored","attributes":{
+				"status":"errored","message":"Deploy","is-destroy":true,"target-addrs":["null_resource.a"],
+				"variables":[{"key":"region","value":"us-east-1"}]},
+				"relationships":{
+					"workspace":{"data":{"type":"workspaces","id":"ws-1"}},
+					"configuration-version":{"data":{"type":"configuration-versions","id":"cv-1"}}}}}`))
+		case "/api/v2/runs":
+			body, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(body))
+			w.Header().Set("Content-Type", ContentTypeJSONAPI)
+			w.WriteHeader(201)
+			w.Write([]byte(`{"data":{"type":"runs","id":"run-retry"}}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "abcd1234",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	t.Run("with default options", func(t *testing.T) {
+		bodies = nil
+
+		r, err := client.Runs.Retry(ctx, "run-errored", RunRetryOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "run-retry", r.ID)
+
+		require.Len(t, bodies, 1)
+		assert.Contains(t, bodies[0], `"message":"retry of run-errored: Deploy"`)
+		assert.Contains(t, bodies[0], `"is-destroy":true`)
+		assert.Contains(t, bodies[0], `"target-addrs":["null_resource.a"]`)
+		assert.Contains(t, bodies[0], `"variables":[{"key":"region","value":"us-east-1"}]`)
+		assert.Contains(t, bodies[0], `"workspace":{"data":{"type":"workspaces","id":"ws-1"}}`)
+		assert.Contains(t, bodies[0], `"configuration-version":{"data":{"type":"configuration-versions","id":"cv-1"}}`)
+	})
+
+	t.Run("with a message", func(t *testing.T) {
+		bodies = nil
+
+		_, err := client.Runs.Retry(ctx, "run-errored", RunRetryOptions{
+			Message: String("Deploy again"),
+		})
+		require.NoError(t, err)
+
+		require.Len(t, bodies, 1)
+		assert.Contains(t, bodies[0], `"message":"Deploy again"`)
+	})
+}
+
+func TestRunsExportSentinelMockData(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	rTest, rTestCleanup := createPlannedRun(t, client, nil)
+	t.Cleanup(rTestCleanup)
+
+	t.Run("with a planned run", func(t *testing.T) {
+		pe, err := client.Runs.ExportSentinelMockData(ctx, rTest.ID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, PlanExportFinished, pe.Status)
+		assert.Equal(t, PlanExportSentinelMockBundleV0, pe.DataType)
+
+		data, err := client.PlanExports.Download(ctx, pe.ID)
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+	})
+
+	t.Run("without a valid run ID", func(t *testing.T) {
+		pe, err := client.Runs.ExportSentinelMockData(ctx, badIdentifier, nil)
+		assert.Nil(t, pe)
+		assert.Equal(t, ErrInvalidRunID, err)
+	})
+}
+
+func TestRunsExportSentinelMockData_Polling(t *testing.T) {
+	ctx := context.Background()
+
+	reads := map[string]int{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(204)
+		case "/api/v2/runs/run-finished", "/api/v2/runs/run-errored":
+			planID := "plan-" + r.URL.Path[len("/api/v2/runs/run-"):]
+			fmt.Fprintf(w, `{"data":{"type":"runs","id":"run-1","relationships":{"plan":{"data":{"type":"plans","id":%q}}}}}`, planID)
+		case "/api/v2/runs/run-without-plan":
+			w.Write([]byte(`{"data":{"type":"runs","id":"run-without-plan"}}`))
+		case "/api/v2/plan-exports":
+			body, _ := io.ReadAll(r.Body)
+			exportID := "pe-finished"
+			if bytes.Contains(body, []byte("plan-errored")) {
+				exportID = "pe-errored"
+			}
+			w.WriteHeader(201)
+			fmt.Fprintf(w, `{"data":{"type":"plan-exports","id":%q,"attributes":{"status":"queued","data-type":"sentinel-mock-bundle-v0"}}}`, exportID)
+		case "/api/v2/plan-exports/pe-finished":
+			reads[r.URL.Path]++
+			status := "queued"
+			if reads[r.URL.Path] > 1 {
+				status = "finished"
+			}
+			fmt.Fprintf(w, `{"data":{"type":"plan-exports","id":"pe-finished","attributes":{"status":%q,"data-type":"sentinel-mock-bundle-v0"}}}`, status)
+		case "/api/v2/plan-exports/pe-errored":
+			w.Write([]byte(`{"data":{"type":"plan-exports","id":"pe-errored","attributes":{"status":"errored","data-type":"sentinel-mock-bundle-v0"}}}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "abcd1234",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	t.Run("when the export finishes", func(t *testing.T) {
+		pe, err := client.Runs.ExportSentinelMockData(ctx, "run-finished", &WaitOptions{PollInterval: time.Millisecond})
+		require.NoError(t, err)
+		assert.Equal(t, "pe-finished", pe.ID)
+		assert.Equal(t, PlanExportFinished, pe.Status)
+		assert.Equal(t, 2, reads["/api/v2/plan-exports/pe-finished"])
+	})
+
+	t.Run("when the export errors", func(t *testing.T) {
+		pe, err := client.Runs.ExportSentinelMockData(ctx, "run-errored", nil)
+		assert.Nil(t, pe)
+		assert.ErrorIs(t, err, ErrPlanExportNotFinished)
+	})
+
+	t.Run("when the export takes too long", func(t *testing.T) {
+		// Keep the export queued for longer than the wait allows.
+		reads["/api/v2/plan-exports/pe-finished"] = -1000
+		pe, err := client.Runs.ExportSentinelMockData(ctx, "run-finished", &WaitOptions{
+			PollInterval: time.Millisecond,
+			MaxWait:      20 * time.Millisecond,
+		})
+		assert.Nil(t, pe)
+		assert.Equal(t, ErrWaitTimeout, err)
+	})
+
+	t.Run("when the run has no plan", func(t *testing.T) {
+		pe, err := client.Runs.ExportSentinelMockData(ctx, "run-without-plan", nil)
+		assert.Nil(t, pe)
+		assert.Equal(t, ErrRunHasNoPlan, err)
+	})
+}
+
 func TestRunsRead_CostEstimate(t *testing.T) {
 	skipIfEnterprise(t)
 
@@ -401,6 +848,119 @@ func TestRunsReadWithOptions(t *testing.T) {
 		require.NotEmpty(t, r.CreatedBy)
 		assert.NotEmpty(t, r.CreatedBy.Username)
 	})
+
+	t.Run("with plan exports included", func(t *testing.T) {
+		prTest, prTestCleanup := createPlannedRun(t, client, nil)
+		defer prTestCleanup()
+
+		peTest, peTestCleanup := createPlanExport(t, client, prTest)
+		defer peTestCleanup()
+
+		r, err := client.Runs.ReadWithOptions(ctx, prTest.ID, &RunReadOptions{
+			Include: []RunIncludeOpt{RunPlanExports},
+		})
+		require.NoError(t, err)
+
+		require.NotNil(t, r.Plan)
+		require.Len(t, r.Plan.Exports, 1)
+		assert.Equal(t, peTest.ID, r.Plan.Exports[0].ID)
+		assert.Equal(t, PlanExportSentinelMockBundleV0, r.Plan.Exports[0].DataType)
+	})
+
+	t.Run("with an invalid include", func(t *testing.T) {
+		r, err := client.Runs.ReadWithOptions(ctx, rTest.ID, &RunReadOptions{
+			Include: []RunIncludeOpt{"plan..exports"},
+		})
+		assert.Nil(t, r)
+		assert.ErrorIs(t, err, ErrInvalidIncludeValue)
+	})
+
+	t.Run("with task stages and policy checks included", func(t *testing.T) {
+		r, err := client.Runs.ReadWithOptions(ctx, rTest.ID, &RunReadOptions{
+			Include: []RunIncludeOpt{RunTaskStages, RunTaskResults, RunPolicyChecks, RunCostEstimate},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rTest.ID, r.ID)
+	})
+
+	t.Run("with too many includes", func(t *testing.T) {
+		r, err := client.Runs.ReadWithOptions(ctx, rTest.ID, &RunReadOptions{
+			Include: []RunIncludeOpt{
+				RunPlan, RunApply, RunCreatedBy, RunCostEstimate, RunConfigVer,
+				RunWorkspace, RunTaskStages, RunTaskResults, RunPolicyChecks,
+			},
+		})
+		assert.Nil(t, r)
+		assert.ErrorIs(t, err, ErrInvalidIncludeValue)
+	})
+}
+
+func TestRunsReadWithOptions_NestedInclude(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		assert.Equal(t, "workspace.project", r.URL.Query().Get("include"))
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		fmt.Fprint(w, `{"data": {"id": "run-123", "type": "runs", "attributes": {"status": "planned"}}}`)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+
+	r, err := client.Runs.ReadWithOptions(context.Background(), "run-123", &RunReadOptions{
+		Include: []RunIncludeOpt{"workspace.project"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "run-123", r.ID)
+}
+
+func TestRunsReadWithOptions_Included(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		assert.Equal(t, "task_stages,task_stages.task_results,policy_checks,cost_estimate", r.URL.Query().Get("include"))
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		fmt.Fprint(w, `{
+			"data": {"id": "run-123", "type": "runs", "attributes": {"status": "planned"},
+				"relationships": {
+					"task-stages": {"data": [{"id": "ts-1", "type": "task-stages"}]},
+					"policy-checks": {"data": [{"id": "polchk-1", "type": "policy-checks"}]},
+					"cost-estimate": {"data": {"id": "ce-1", "type": "cost-estimates"}}
+				}},
+			"included": [
+				{"id": "ts-1", "type": "task-stages", "attributes": {"stage": "post_plan", "status": "passed"},
+					"relationships": {"task-results": {"data": [{"id": "taskrs-1", "type": "task-results"}]}}},
+				{"id": "taskrs-1", "type": "task-results", "attributes": {"task-name": "scanner", "status": "passed"}},
+				{"id": "polchk-1", "type": "policy-checks", "attributes": {"status": "passed"}},
+				{"id": "ce-1", "type": "cost-estimates", "attributes": {"status": "finished", "delta-monthly-cost": "1.50"}}
+			]
+		}`)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+
+	r, err := client.Runs.ReadWithOptions(context.Background(), "run-123", &RunReadOptions{
+		Include: []RunIncludeOpt{RunTaskStages, RunTaskResults, RunPolicyChecks, RunCostEstimate},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, r.TaskStages, 1)
+	assert.Equal(t, PostPlan, r.TaskStages[0].Stage)
+	require.Len(t, r.TaskStages[0].TaskResults, 1)
+	assert.Equal(t, "scanner", r.TaskStages[0].TaskResults[0].TaskName)
+	require.Len(t, r.PolicyChecks, 1)
+	assert.Equal(t, PolicyPasses, r.PolicyChecks[0].Status)
+	require.NotNil(t, r.CostEstimate)
+	assert.Equal(t, "1.50", r.CostEstimate.DeltaMonthlyCost)
 }
 
 func TestRunsApply(t *testing.T) {
@@ -619,16 +1179,62 @@ func TestRunsDiscard(t *testing.T) {
 	})
 }
 
+func TestRunsReadWithOptions_IfNoneMatch(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		assert.Equal(t, "/api/v2/runs/run-123", r.URL.Path)
+		assert.Empty(t, r.URL.Query().Get("IfNoneMatch"))
+		if r.Header.Get("If-None-Match") == `W/"abc"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		w.Header().Set("ETag", `W/"abc"`)
+		fmt.Fprint(w, `{"data":{"id":"run-123","type":"runs","attributes":{"status":"applied"}}}`)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	var etag string
+	_, err = client.Runs.Read(ContextWithETag(ctx, &etag), "run-123")
+	require.NoError(t, err)
+	assert.Equal(t, `W/"abc"`, etag)
+
+	t.Run("when the run has not changed", func(t *testing.T) {
+		cached, err := client.Runs.ReadWithOptions(ctx, "run-123", &RunReadOptions{IfNoneMatch: etag})
+		assert.Nil(t, cached)
+		assert.Equal(t, ErrNotModified, err)
+	})
+
+	t.Run("when the run has changed", func(t *testing.T) {
+		var etag string
+		r, err := client.Runs.ReadWithOptions(ContextWithETag(ctx, &etag), "run-123", &RunReadOptions{IfNoneMatch: `W/"old"`})
+		require.NoError(t, err)
+		assert.Equal(t, RunApplied, r.Status)
+		assert.Equal(t, `W/"abc"`, etag)
+	})
+}
+
 func TestRun_Unmarshal(t *testing.T) {
 	data := map[string]interface{}{
 		"data": map[string]interface{}{
 			"type": "runs",
 			"id":   "1",
 			"attributes": map[string]interface{}{
-				"created-at":  "2018-03-02T23:42:06.651Z",
-				"has-changes": true,
-				"is-destroy":  false,
-				"message":     "run message",
+				"created-at":        "2018-03-02T23:42:06.651Z",
+				"has-changes":       true,
+				"is-destroy":        false,
+				"message":           "run message",
+				"position-in-queue": 3,
+				"terraform-version": "1.6.2",
 				"actions": map[string]interface{}{
 					"is-cancelable":       true,
 					"is-confirmable":      true,
@@ -671,6 +1277,8 @@ func TestRun_Unmarshal(t *testing.T) {
 	assert.Equal(t, run.HasChanges, true)
 	assert.Equal(t, run.IsDestroy, false)
 	assert.Equal(t, run.Message, "run message")
+	assert.Equal(t, run.PositionInQueue, 3)
+	assert.Equal(t, run.TerraformVersion, "1.6.2")
 	assert.Equal(t, run.Actions.IsConfirmable, true)
 	assert.Equal(t, run.Actions.IsCancelable, true)
 	assert.Equal(t, run.Actions.IsDiscardable, true)
@@ -688,6 +1296,28 @@ func TestRun_Unmarshal(t *testing.T) {
 	assert.Equal(t, run.Variables[0].Value, "\"a-value\"")
 }
 
+func TestRun_UnmarshalTargetAndReplaceAddrs(t *testing.T) {
+	t.Run("with a targeted run", func(t *testing.T) {
+		data, err := os.ReadFile("test-fixtures/run/targeted.json")
+		require.NoError(t, err)
+
+		run := &Run{}
+		require.NoError(t, unmarshalResponse(bytes.NewReader(data), run))
+		assert.Equal(t, []string{"aws_instance.web", `module.network.aws_subnet.private["a"]`}, run.TargetAddrs)
+		assert.Equal(t, []string{"aws_instance.web"}, run.ReplaceAddrs)
+	})
+
+	t.Run("with a run of the whole configuration", func(t *testing.T) {
+		data, err := os.ReadFile("test-fixtures/run/untargeted.json")
+		require.NoError(t, err)
+
+		run := &Run{}
+		require.NoError(t, unmarshalResponse(bytes.NewReader(data), run))
+		assert.Empty(t, run.TargetAddrs)
+		assert.Empty(t, run.ReplaceAddrs)
+	})
+}
+
 func TestRunCreateOptions_Marshal(t *testing.T) {
 	client := testClient(t)
 
@@ -720,3 +1350,101 @@ func TestRunCreateOptions_Marshal(t *testing.T) {
 
 	assert.Equal(t, string(bodyBytes), expectedBody)
 }
+
+func TestRunsStreamLogs(t *testing.T) {
+	logs := map[string]string{
+		"/logs/plan-1":  "\x02Terraform v1.6.0\r\nPlan: 1 to add, 0 to change, 0 to destroy.\n\x03",
+		"/logs/apply-1": "\x02Apply complete! Resources: 1 added, 0 changed, 0 destroyed.\x03",
+		"/logs/plan-2":  "\x02No changes.\n\x03",
+	}
+
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/runs/run-applied":
+			fmt.Fprint(w, `{"data": {"id": "run-applied", "type": "runs", "attributes": {"status": "applied"},
+				"relationships": {"plan": {"data": {"id": "plan-1", "type": "plans"}}, "apply": {"data": {"id": "apply-1", "type": "applies"}}}}}`)
+		case "/api/v2/runs/run-discarded":
+			fmt.Fprint(w, `{"data": {"id": "run-discarded", "type": "runs", "attributes": {"status": "discarded"},
+				"relationships": {"plan": {"data": {"id": "plan-2", "type": "plans"}}, "apply": {"data": {"id": "apply-2", "type": "applies"}}}}}`)
+		case "/api/v2/runs/run-pending":
+			fmt.Fprint(w, `{"data": {"id": "run-pending", "type": "runs", "attributes": {"status": "pending"},
+				"relationships": {"plan": {"data": null}}}}`)
+		case "/api/v2/plans/plan-1", "/api/v2/plans/plan-2":
+			id := path.Base(r.URL.Path)
+			fmt.Fprintf(w, `{"data": {"id": %q, "type": "plans", "attributes": {"status": "finished", "log-read-url": %q}}}`,
+				id, ts.URL+"/logs/"+id)
+		case "/api/v2/applies/apply-1":
+			fmt.Fprintf(w, `{"data": {"id": "apply-1", "type": "applies", "attributes": {"status": "finished", "log-read-url": %q}}}`,
+				ts.URL+"/logs/apply-1")
+		case "/api/v2/applies/apply-2":
+			fmt.Fprint(w, `{"data": {"id": "apply-2", "type": "applies", "attributes": {"status": "unreachable", "log-read-url": ""}}}`)
+		default:
+			log, ok := logs[r.URL.Path]
+			if !ok {
+				t.Errorf("unexpected request to %s", r.URL.Path)
+				return
+			}
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			if offset < len(log) {
+				fmt.Fprint(w, log[offset:])
+			}
+		}
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	collect := func(lines <-chan LogLineOrError) []LogLineOrError {
+		var out []LogLineOrError
+		for line := range lines {
+			out = append(out, line)
+		}
+		return out
+	}
+
+	t.Run("when the run was applied", func(t *testing.T) {
+		lines, err := client.Runs.StreamLogs(ctx, "run-applied")
+		require.NoError(t, err)
+		assert.Equal(t, []LogLineOrError{
+			{Phase: RunLogPlan, Line: "Terraform v1.6.0"},
+			{Phase: RunLogPlan, Line: "Plan: 1 to add, 0 to change, 0 to destroy."},
+			{Phase: RunLogApply, Line: "Apply complete! Resources: 1 added, 0 changed, 0 destroyed."},
+		}, collect(lines))
+	})
+
+	t.Run("when the run ended without an apply", func(t *testing.T) {
+		lines, err := client.Runs.StreamLogs(ctx, "run-discarded")
+		require.NoError(t, err)
+		assert.Equal(t, []LogLineOrError{
+			{Phase: RunLogPlan, Line: "No changes."},
+		}, collect(lines))
+	})
+
+	t.Run("when the context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		lines, err := client.Runs.StreamLogs(ctx, "run-applied")
+		require.NoError(t, err)
+		cancel()
+
+		// The stream must be closed without waiting for the run.
+		collect(lines)
+	})
+
+	t.Run("when the run has no plan", func(t *testing.T) {
+		lines, err := client.Runs.StreamLogs(ctx, "run-pending")
+		assert.Nil(t, lines)
+		assert.Equal(t, ErrRunHasNoPlan, err)
+	})
+
+	t.Run("with an invalid run ID", func(t *testing.T) {
+		lines, err := client.Runs.StreamLogs(ctx, badIdentifier)
+		assert.Nil(t, lines)
+		assert.Equal(t, ErrInvalidRunID, err)
+	})
+}
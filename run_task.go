@@ -53,10 +53,34 @@ type RunTask struct {
 	HMACKey     *string `jsonapi:"attr,hmac-key,omitempty"`
 	Enabled     bool    `jsonapi:"attr,enabled"`
 
+	// Global is set when the run task is configured as a global run task,
+	// which is attached to every workspace in the organization.
+	Global *GlobalRunTask `jsonapi:"attr,global-configuration,omitempty"`
+
 	Organization      *Organization       `jsonapi:"relation,organization"`
 	WorkspaceRunTasks []*WorkspaceRunTask `jsonapi:"relation,workspace-tasks"`
 }
 
+// GlobalRunTask represents the global configuration of a run task
+type GlobalRunTask struct {
+	Enabled bool `jsonapi:"attr,enabled"`
+	// Stages holds the Stage values in which the global run task runs.
+	Stages           []string             `jsonapi:"attr,stages"`
+	EnforcementLevel TaskEnforcementLevel `jsonapi:"attr,enforcement-level"`
+}
+
+// GlobalRunTaskOptions represents the global configuration options of a run task
+type GlobalRunTaskOptions struct {
+	// Optional: Whether the run task is attached to every workspace in the organization
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Optional: The stages in which the global run task runs
+	Stages *[]Stage `json:"stages,omitempty"`
+
+	// Optional: The enforcement level of the global run task
+	EnforcementLevel *TaskEnforcementLevel `json:"enforcement-level,omitempty"`
+}
+
 // RunTaskList represents a list of run tasks
 type RunTaskList struct {
 	*Pagination
@@ -112,6 +136,9 @@ type RunTaskCreateOptions struct {
 
 	// Optional: Whether the task should be enabled
 	Enabled *bool `jsonapi:"attr,enabled,omitempty"`
+
+	// Optional: The global configuration of the run task
+	Global *GlobalRunTaskOptions `jsonapi:"attr,global-configuration,omitempty"`
 }
 
 // RunTaskUpdateOptions represents the set of options for updating an organization's run task
@@ -139,6 +166,9 @@ type RunTaskUpdateOptions struct {
 
 	// Optional: Whether the task should be enabled
 	Enabled *bool `jsonapi:"attr,enabled,omitempty"`
+
+	// Optional: The global configuration of the run task
+	Global *GlobalRunTaskOptions `jsonapi:"attr,global-configuration,omitempty"`
 }
 
 // Create is used to create a new run task for an organization
@@ -280,6 +310,10 @@ func (o *RunTaskCreateOptions) valid() error {
 		return ErrInvalidRunTaskCategory
 	}
 
+	if o.Global != nil {
+		return o.Global.valid()
+	}
+
 	return nil
 }
 
@@ -296,6 +330,34 @@ func (o *RunTaskUpdateOptions) valid() error {
 		return ErrInvalidRunTaskCategory
 	}
 
+	if o.Global != nil {
+		return o.Global.valid()
+	}
+
+	return nil
+}
+
+func (o *GlobalRunTaskOptions) valid() error {
+	if o.Stages != nil {
+		for _, stage := range *o.Stages {
+			switch stage {
+			case PrePlan, PostPlan, PreApply:
+				// do nothing
+			default:
+				return ErrInvalidRunTaskStage
+			}
+		}
+	}
+
+	if o.EnforcementLevel != nil {
+		switch *o.EnforcementLevel {
+		case Advisory, Mandatory:
+			// do nothing
+		default:
+			return ErrInvalidTaskEnforcementLevel
+		}
+	}
+
 	return nil
 }
 
@@ -4,10 +4,13 @@
 package tfe
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"os"
 	"testing"
 
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -178,6 +181,43 @@ func TestRunTasksUpdate(t *testing.T) {
 
 		assert.Equal(t, newDescription, r.Description)
 	})
+
+	t.Run("make task global", func(t *testing.T) {
+		r, err := client.RunTasks.Update(ctx, runTaskTest.ID, RunTaskUpdateOptions{
+			Global: &GlobalRunTaskOptions{
+				Enabled:          Bool(true),
+				Stages:           &[]Stage{PrePlan, PostPlan},
+				EnforcementLevel: TaskEnforcement(Mandatory),
+			},
+		})
+		require.NoError(t, err)
+
+		r, err = client.RunTasks.Read(ctx, r.ID)
+		require.NoError(t, err)
+
+		require.NotNil(t, r.Global)
+		assert.True(t, r.Global.Enabled)
+		assert.ElementsMatch(t, []string{string(PrePlan), string(PostPlan)}, r.Global.Stages)
+		assert.Equal(t, Mandatory, r.Global.EnforcementLevel)
+	})
+
+	t.Run("with an invalid global stage", func(t *testing.T) {
+		_, err := client.RunTasks.Update(ctx, runTaskTest.ID, RunTaskUpdateOptions{
+			Global: &GlobalRunTaskOptions{
+				Stages: &[]Stage{"post_apply"},
+			},
+		})
+		assert.Equal(t, ErrInvalidRunTaskStage, err)
+	})
+
+	t.Run("with an invalid global enforcement level", func(t *testing.T) {
+		_, err := client.RunTasks.Update(ctx, runTaskTest.ID, RunTaskUpdateOptions{
+			Global: &GlobalRunTaskOptions{
+				EnforcementLevel: TaskEnforcement("strict"),
+			},
+		})
+		assert.Equal(t, ErrInvalidTaskEnforcementLevel, err)
+	})
 }
 
 func TestRunTasksDelete(t *testing.T) {
@@ -237,3 +277,59 @@ func TestRunTasksAttachToWorkspace(t *testing.T) {
 		require.NotNil(t, wr.ID)
 	})
 }
+
+func TestRunTaskUpdateOptions_Marshal(t *testing.T) {
+	opts := RunTaskUpdateOptions{
+		Global: &GlobalRunTaskOptions{
+			Enabled:          Bool(true),
+			Stages:           &[]Stage{PrePlan},
+			EnforcementLevel: TaskEnforcement(Advisory),
+		},
+	}
+
+	reqBody, err := serializeRequestBody(&opts)
+	require.NoError(t, err)
+	req, err := retryablehttp.NewRequest("PATCH", "url", reqBody)
+	require.NoError(t, err)
+	bodyBytes, err := req.BodyBytes()
+	require.NoError(t, err)
+
+	expectedBody := `{"data":{"type":"tasks","attributes":{"global-configuration":{"enabled":true,"stages":["pre_plan"],"enforcement-level":"advisory"}}}}
+`
+	assert.Equal(t, expectedBody, string(bodyBytes))
+}
+
+func TestRunTask_Unmarshal(t *testing.T) {
+	data := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "tasks",
+			"id":   "task-1",
+			"attributes": map[string]interface{}{
+				"name":     "scanner",
+				"url":      "https://example.com/scan",
+				"category": "task",
+				"enabled":  true,
+				"global-configuration": map[string]interface{}{
+					"enabled":           true,
+					"stages":            []string{"pre_plan", "post_plan"},
+					"enforcement-level": "mandatory",
+				},
+			},
+		},
+	}
+
+	byteData, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	responseBody := bytes.NewReader(byteData)
+	rt := &RunTask{}
+	err = unmarshalResponse(responseBody, rt)
+	require.NoError(t, err)
+
+	assert.Equal(t, "task-1", rt.ID)
+	assert.Equal(t, "scanner", rt.Name)
+	require.NotNil(t, rt.Global)
+	assert.True(t, rt.Global.Enabled)
+	assert.Equal(t, []string{string(PrePlan), string(PostPlan)}, rt.Global.Stages)
+	assert.Equal(t, Mandatory, rt.Global.EnforcementLevel)
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"sort"
 	"strings"
 	"time"
 
@@ -58,11 +59,20 @@ type StateVersions interface {
 	// Download retrieves the actual stored state of a state version
 	Download(ctx context.Context, url string) ([]byte, error)
 
+	// ReadDownloadURL returns the temporary signed URL of the stored state of
+	// a state version without downloading it.
+	ReadDownloadURL(ctx context.Context, svID string) (string, error)
+
 	// ListOutputs retrieves all the outputs of a state version by its ID. IMPORTANT: Terraform Cloud might
 	// process outputs asynchronously. When consuming outputs or other async StateVersion fields, be sure to
 	// wait for ResourcesProcessed to become `true` before assuming they are empty.
 	ListOutputs(ctx context.Context, svID string, options *StateVersionOutputsListOptions) (*StateVersionOutputsList, error)
 
+	// ListResources lists the resources recorded in a state version. Like the outputs, the resources
+	// are processed asynchronously, so wait for ResourcesProcessed to become `true` before assuming
+	// they are empty.
+	ListResources(ctx context.Context, svID string, options *StateVersionResourcesListOptions) (*StateVersionResourceList, error)
+
 	// SoftDeleteBackingData soft deletes the state version's backing data
 	// **Note: This functionality is only available in Terraform Enterprise.**
 	SoftDeleteBackingData(ctx context.Context, svID string) error
@@ -156,6 +166,36 @@ type StateVersionOutputsListOptions struct {
 	ListOptions
 }
 
+// StateVersionResourcesListOptions represents the options for listing state
+// version resources.
+type StateVersionResourcesListOptions struct {
+	ListOptions
+}
+
+// StateVersionResourceList represents a list of state version resources.
+type StateVersionResourceList struct {
+	*Pagination
+	Items []*StateVersionResource
+}
+
+// StateVersionResource represents a resource recorded in a state version,
+// aggregating all the instances of the resource.
+type StateVersionResource struct {
+	// The address of the resource, e.g. "module.network.aws_vpc.main".
+	Address string
+	Type    string
+	Name    string
+	// The module containing the resource, "root" for the root module.
+	Module string
+	// The provider configuration address, e.g.
+	// provider["registry.terraform.io/hashicorp/aws"].
+	Provider string
+	// The type of the provider, e.g. "aws".
+	ProviderType string
+	// The number of instances of the resource.
+	Count int
+}
+
 // StateVersionCurrentOptions represents the options for reading the current state version.
 type StateVersionCurrentOptions struct {
 	// Optional: A list of relations to include. See available resources:
@@ -387,6 +427,22 @@ func (s *stateVersions) Download(ctx context.Context, u string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
+// ReadDownloadURL returns the temporary signed URL of the stored state of a
+// state version. ErrStateVersionNotUploaded is returned while the state
+// version has no state to download.
+func (s *stateVersions) ReadDownloadURL(ctx context.Context, svID string) (string, error) {
+	sv, err := s.Read(ctx, svID)
+	if err != nil {
+		return "", err
+	}
+
+	if sv.DownloadURL == "" {
+		return "", ErrStateVersionNotUploaded
+	}
+
+	return sv.DownloadURL, nil
+}
+
 // ListOutputs retrieves all the outputs of a state version by its ID. IMPORTANT: Terraform Cloud might
 // process outputs asynchronously. When consuming outputs or other async StateVersion fields, be sure to
 // wait for ResourcesProcessed to become `true` before assuming they are empty.
@@ -410,6 +466,97 @@ func (s *stateVersions) ListOutputs(ctx context.Context, svID string, options *S
 	return sv, nil
 }
 
+// ListResources lists the resources recorded in a state version. The API
+// returns them as part of the state version, so they are paginated here
+// using the page number and size of the options, sorted by address.
+func (s *stateVersions) ListResources(ctx context.Context, svID string, options *StateVersionResourcesListOptions) (*StateVersionResourceList, error) {
+	sv, err := s.Read(ctx, svID)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]*StateVersionResource, 0, len(sv.Resources))
+	for _, r := range sv.Resources {
+		if r == nil {
+			continue
+		}
+
+		address := r.Type + "." + r.Name
+		if r.Module != "" && r.Module != "root" {
+			address = r.Module + "." + address
+		}
+
+		resources = append(resources, &StateVersionResource{
+			Address:      address,
+			Type:         r.Type,
+			Name:         r.Name,
+			Module:       r.Module,
+			Provider:     r.Provider,
+			ProviderType: providerTypeFromConfigAddress(r.Provider),
+			Count:        r.Count,
+		})
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].Address < resources[j].Address
+	})
+
+	pageNumber, pageSize := 1, 20
+	if options != nil {
+		if options.PageNumber > 0 {
+			pageNumber = options.PageNumber
+		}
+		if options.PageSize > 0 {
+			pageSize = options.PageSize
+		}
+	}
+
+	totalPages := (len(resources) + pageSize - 1) / pageSize
+	pagination := &Pagination{
+		CurrentPage: pageNumber,
+		TotalPages:  totalPages,
+		TotalCount:  len(resources),
+	}
+	if pageNumber > 1 {
+		pagination.PreviousPage = pageNumber - 1
+	}
+	if pageNumber < totalPages {
+		pagination.NextPage = pageNumber + 1
+	}
+
+	start := (pageNumber - 1) * pageSize
+	if start > len(resources) {
+		start = len(resources)
+	}
+	end := start + pageSize
+	if end > len(resources) {
+		end = len(resources)
+	}
+
+	return &StateVersionResourceList{
+		Pagination: pagination,
+		Items:      resources[start:end],
+	}, nil
+}
+
+// providerTypeFromConfigAddress returns the provider type of a provider
+// configuration address such as provider["registry.terraform.io/hashicorp/aws"]
+// or, as written by Terraform 0.12 and earlier, provider.aws.
+func providerTypeFromConfigAddress(address string) string {
+	if legacy := strings.TrimPrefix(address, "provider."); legacy != address {
+		providerType, _, _ := strings.Cut(legacy, ".")
+		return providerType
+	}
+
+	start := strings.Index(address, `["`)
+	end := strings.LastIndex(address, `"]`)
+	if start < 0 || end <= start {
+		return ""
+	}
+
+	source := address[start+2 : end]
+	return source[strings.LastIndex(source, "/")+1:]
+}
+
 func (s *stateVersions) SoftDeleteBackingData(ctx context.Context, svID string) error {
 	return s.manageBackingData(ctx, svID, "soft_delete_backing_data")
 }
@@ -8,6 +8,8 @@ import (
 	"crypto/md5"
 	"encoding/base64"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
@@ -462,6 +464,30 @@ func TestStateVersionsRead(t *testing.T) {
 	})
 }
 
+func TestStateVersionsReadDownloadURL(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	svTest, svTestCleanup := createStateVersion(t, client, 0, nil)
+	t.Cleanup(svTestCleanup)
+
+	t.Run("when the state version exists", func(t *testing.T) {
+		u, err := client.StateVersions.ReadDownloadURL(ctx, svTest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, svTest.DownloadURL, u)
+
+		state, err := client.StateVersions.Download(ctx, u)
+		require.NoError(t, err)
+		assert.NotEmpty(t, state)
+	})
+
+	t.Run("with invalid state version id", func(t *testing.T) {
+		u, err := client.StateVersions.ReadDownloadURL(ctx, badIdentifier)
+		assert.Empty(t, u)
+		assert.Equal(t, ErrInvalidStateVerID, err)
+	})
+}
+
 func TestStateVersionsReadWithOptions(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -635,6 +661,115 @@ func TestStateVersionOutputs(t *testing.T) {
 	})
 }
 
+func TestStateVersionResources(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	t.Cleanup(wTestCleanup)
+
+	sv, svTestCleanup := createStateVersion(t, client, 0, wTest)
+	t.Cleanup(svTestCleanup)
+
+	// give TFC some time to process the statefile and extract the resources.
+	waitForSVOutputs(t, client, sv.ID)
+
+	t.Run("when the state version exists", func(t *testing.T) {
+		resources, err := client.StateVersions.ListResources(ctx, sv.ID, nil)
+		require.NoError(t, err)
+
+		// Based on fixture test-fixtures/state-version/terraform.tfstate
+		require.Len(t, resources.Items, 1)
+		assert.Equal(t, "null_resource.test", resources.Items[0].Address)
+		assert.Equal(t, "null_resource", resources.Items[0].Type)
+		assert.Equal(t, "test", resources.Items[0].Name)
+		assert.Equal(t, "null", resources.Items[0].ProviderType)
+		assert.Equal(t, 1, resources.TotalCount)
+	})
+
+	t.Run("with invalid state version id", func(t *testing.T) {
+		resources, err := client.StateVersions.ListResources(ctx, badIdentifier, nil)
+		assert.Nil(t, resources)
+		assert.Equal(t, ErrInvalidStateVerID, err)
+	})
+}
+
+func TestStateVersionResources_Pagination(t *testing.T) {
+	ctx := context.Background()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(204)
+		case "/api/v2/state-versions/sv-1":
+			w.Header().Set("Content-Type", ContentTypeJSONAPI)
+			w.Write([]byte(`{"data":{"type":"state-versions","id":"sv-1","attributes":{"resources-processed":true,"resources":[
+				{"name":"main","type":"aws_vpc","count":1,"module":"module.network","provider":"provider[\"registry.terraform.io/hashicorp/aws\"]"},
+				{"name":"web","type":"aws_instance","count":2,"module":"root","provider":"provider[\"registry.terraform.io/hashicorp/aws\"].west"},
+				{"name":"remote","type":"data.terraform_remote_state","count":1,"module":"root","provider":"provider[\"terraform.io/builtin/terraform\"]"}]}}}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "abcd1234",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	t.Run("with the first page", func(t *testing.T) {
+		resources, err := client.StateVersions.ListResources(ctx, "sv-1", &StateVersionResourcesListOptions{
+			ListOptions: ListOptions{PageSize: 2},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []*StateVersionResource{
+			{
+				Address:      "aws_instance.web",
+				Type:         "aws_instance",
+				Name:         "web",
+				Module:       "root",
+				Provider:     `provider["registry.terraform.io/hashicorp/aws"].west`,
+				ProviderType: "aws",
+				Count:        2,
+			},
+			{
+				Address:      "data.terraform_remote_state.remote",
+				Type:         "data.terraform_remote_state",
+				Name:         "remote",
+				Module:       "root",
+				Provider:     `provider["terraform.io/builtin/terraform"]`,
+				ProviderType: "terraform",
+				Count:        1,
+			},
+		}, resources.Items)
+		assert.Equal(t, &Pagination{CurrentPage: 1, NextPage: 2, TotalPages: 2, TotalCount: 3}, resources.Pagination)
+	})
+
+	t.Run("with the last page", func(t *testing.T) {
+		resources, err := client.StateVersions.ListResources(ctx, "sv-1", &StateVersionResourcesListOptions{
+			ListOptions: ListOptions{PageNumber: 2, PageSize: 2},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, resources.Items, 1)
+		assert.Equal(t, "module.network.aws_vpc.main", resources.Items[0].Address)
+		assert.Equal(t, &Pagination{CurrentPage: 2, PreviousPage: 1, TotalPages: 2, TotalCount: 3}, resources.Pagination)
+	})
+
+	t.Run("past the last page", func(t *testing.T) {
+		resources, err := client.StateVersions.ListResources(ctx, "sv-1", &StateVersionResourcesListOptions{
+			ListOptions: ListOptions{PageNumber: 999},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, resources.Items)
+		assert.Equal(t, 3, resources.TotalCount)
+	})
+}
+
 func TestStateVersions_ManageBackingData(t *testing.T) {
 	skipUnlessEnterprise(t)
 
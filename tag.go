@@ -3,6 +3,8 @@
 
 package tfe
 
+import "strings"
+
 type TagList struct {
 	*Pagination
 	Items []*Tag
@@ -13,3 +15,74 @@ type Tag struct {
 	ID   string `jsonapi:"primary,tags"`
 	Name string `jsonapi:"attr,name,omitempty"`
 }
+
+// TagBinding is a key/value tag bound to a project or workspace.
+type TagBinding struct {
+	ID    string `jsonapi:"primary,tag-bindings"`
+	Key   string `jsonapi:"attr,key"`
+	Value string `jsonapi:"attr,value,omitempty"`
+}
+
+// TagBindingSource represents where an effective tag binding is defined.
+type TagBindingSource string
+
+// List all available tag binding sources.
+const (
+	TagBindingSourceOrganization TagBindingSource = "organization"
+	TagBindingSourceProject      TagBindingSource = "project"
+	TagBindingSourceWorkspace    TagBindingSource = "workspace"
+)
+
+// EffectiveTagBinding is a tag binding that applies to a project or
+// workspace, either because it is bound directly or because it is
+// inherited from a parent in the hierarchy.
+type EffectiveTagBinding struct {
+	ID    string `jsonapi:"primary,effective-tag-bindings"`
+	Key   string `jsonapi:"attr,key"`
+	Value string `jsonapi:"attr,value,omitempty"`
+
+	// Source is where the tag binding is defined. It is derived from the
+	// "inherited-from" link of the tag binding.
+	Source TagBindingSource
+
+	// Links
+	Links map[string]interface{} `jsonapi:"links,omitempty"`
+}
+
+// tagBindingList represents a list of tag bindings.
+type tagBindingList struct {
+	*Pagination
+	Items []*TagBinding
+}
+
+// effectiveTagBindingList represents a list of effective tag bindings.
+type effectiveTagBindingList struct {
+	*Pagination
+	Items []*EffectiveTagBinding
+}
+
+// setSources derives the source of each effective tag binding from its
+// "inherited-from" link. Tag bindings without such a link are bound directly
+// on the resource they were listed for, so they get the given source.
+func (l *effectiveTagBindingList) setSources(self TagBindingSource) {
+	for _, b := range l.Items {
+		var inheritedFrom string
+		switch v := b.Links["inherited-from"].(type) {
+		case string:
+			inheritedFrom = v
+		case map[string]interface{}:
+			inheritedFrom, _ = v["href"].(string)
+		}
+
+		switch {
+		case inheritedFrom == "":
+			b.Source = self
+		case strings.Contains(inheritedFrom, "/workspaces/"):
+			b.Source = TagBindingSourceWorkspace
+		case strings.Contains(inheritedFrom, "/projects/"):
+			b.Source = TagBindingSourceProject
+		default:
+			b.Source = TagBindingSourceOrganization
+		}
+	}
+}
@@ -4,6 +4,7 @@
 package tfe
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"time"
@@ -16,6 +17,10 @@ var _ TaskResults = (*taskResults)(nil)
 type TaskResults interface {
 	// Read a task result by ID
 	Read(ctx context.Context, taskResultID string) (*TaskResult, error)
+
+	// ReadURL fetches the content of the URL posted by the run task
+	// integration for a task result.
+	ReadURL(ctx context.Context, taskResultID string) ([]byte, error)
 }
 
 // taskResults implements TaskResults
@@ -91,3 +96,24 @@ func (t *taskResults) Read(ctx context.Context, taskResultID string) (*TaskResul
 
 	return r, nil
 }
+
+// ReadURL fetches the content of the URL posted by the run task integration
+// for a task result, e.g. the findings of a security scanner.
+func (t *taskResults) ReadURL(ctx context.Context, taskResultID string) ([]byte, error) {
+	r, err := t.Read(ctx, taskResultID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return an error if the integration did not post a URL.
+	if r.URL == "" {
+		return nil, fmt.Errorf("task result %s does not have a URL", taskResultID)
+	}
+
+	var buf bytes.Buffer
+	if err := t.client.doForeignGETRequest(ctx, r.URL, &buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
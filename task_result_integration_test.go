@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskResult_Unmarshal(t *testing.T) {
+	data := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "task-results",
+			"id":   "taskrs-1",
+			"attributes": map[string]interface{}{
+				"status":  TaskFailed,
+				"message": "2 high severity findings",
+				"url":     "https://scanner.example.com/results/1",
+				"status-timestamps": map[string]string{
+					"running-at": "2020-03-16T23:15:59+00:00",
+					"failed-at":  "2020-03-16T23:23:59+00:00",
+				},
+			},
+		},
+	}
+
+	byteData, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	responseBody := bytes.NewReader(byteData)
+	tr := &TaskResult{}
+	err = unmarshalResponse(responseBody, tr)
+	require.NoError(t, err)
+
+	runningParsedTime, err := time.Parse(time.RFC3339, "2020-03-16T23:15:59+00:00")
+	require.NoError(t, err)
+	failedParsedTime, err := time.Parse(time.RFC3339, "2020-03-16T23:23:59+00:00")
+	require.NoError(t, err)
+
+	assert.Equal(t, tr.ID, "taskrs-1")
+	assert.Equal(t, tr.Status, TaskFailed)
+	assert.Equal(t, tr.Message, "2 high severity findings")
+	assert.Equal(t, tr.URL, "https://scanner.example.com/results/1")
+	assert.Equal(t, tr.StatusTimestamps.RunningAt, runningParsedTime)
+	assert.Equal(t, tr.StatusTimestamps.FailedAt, failedParsedTime)
+}
+
+func TestTaskResultsReadURL(t *testing.T) {
+	ctx := context.Background()
+
+	var resultURL string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(204)
+		case "/api/v2/task-results/taskrs-with-url", "/api/v2/task-results/taskrs-without-url":
+			u := ""
+			if r.URL.Path == "/api/v2/task-results/taskrs-with-url" {
+				u = resultURL
+			}
+			w.Header().Set("Content-Type", ContentTypeJSONAPI)
+			fmt.Fprintf(w, `{"data":{"type":"task-results","id":"taskrs-1","attributes":{"status":"failed","url":%q}}}`, u)
+		case "/results/1":
+			if r.Header.Get("Authorization") != "" {
+				w.WriteHeader(400)
+				return
+			}
+			w.Write([]byte(`{"findings":2}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+	resultURL = ts.URL + "/results/1"
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "abcd1234",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	t.Run("when the task result has a URL", func(t *testing.T) {
+		body, err := client.TaskResults.ReadURL(ctx, "taskrs-with-url")
+		require.NoError(t, err)
+		assert.Equal(t, `{"findings":2}`, string(body))
+	})
+
+	t.Run("when the task result does not have a URL", func(t *testing.T) {
+		body, err := client.TaskResults.ReadURL(ctx, "taskrs-without-url")
+		assert.Nil(t, body)
+		assert.Error(t, err)
+	})
+
+	t.Run("with invalid task result ID", func(t *testing.T) {
+		body, err := client.TaskResults.ReadURL(ctx, badIdentifier)
+		assert.Nil(t, body)
+		assert.Equal(t, err, ErrInvalidTaskResultID)
+	})
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+
+	"github.com/hashicorp/jsonapi"
 )
 
 // Compile-time proof of interface implementation.
@@ -26,11 +28,21 @@ type Teams interface {
 	// Read a team by its ID.
 	Read(ctx context.Context, teamID string) (*Team, error)
 
+	// ReadWithOptions reads a team by its ID using the options supplied.
+	ReadWithOptions(ctx context.Context, teamID string, options *TeamReadOptions) (*Team, error)
+
 	// Update a team by its ID.
 	Update(ctx context.Context, teamID string, options TeamUpdateOptions) (*Team, error)
 
 	// Delete a team by its ID.
 	Delete(ctx context.Context, teamID string) error
+
+	// UpdateSSOTeamID maps a team to an identity provider group for SAML/SSO
+	// team membership sync.
+	UpdateSSOTeamID(ctx context.Context, teamID string, ssoTeamID string) (*Team, error)
+
+	// ClearSSOTeamID removes the identity provider group mapping of a team.
+	ClearSSOTeamID(ctx context.Context, teamID string) (*Team, error)
 }
 
 // teams implements Teams.
@@ -100,6 +112,13 @@ type TeamListOptions struct {
 	Names []string `url:"filter[names],omitempty"`
 }
 
+// TeamReadOptions represents the options for reading a team.
+type TeamReadOptions struct {
+	// Optional: A list of relations to include.
+	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/teams#available-related-resources
+	Include []TeamIncludeOpt `url:"include,omitempty"`
+}
+
 // TeamCreateOptions represents the options for creating a team.
 type TeamCreateOptions struct {
 	// Type is a public field utilized by JSON:API to
@@ -142,6 +161,19 @@ type TeamUpdateOptions struct {
 	Visibility *string `jsonapi:"attr,visibility,omitempty"`
 }
 
+// teamSSOTeamIDOptions represents the options for setting or clearing the SSO
+// team ID of a team.
+type teamSSOTeamIDOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,teams"`
+
+	// The SSO team ID, or null to remove the mapping.
+	SSOTeamID jsonapi.NullableAttr[string] `jsonapi:"attr,sso-team-id"`
+}
+
 // OrganizationAccessOptions represents the organization access options of a team.
 type OrganizationAccessOptions struct {
 	ManagePolicies        *bool `json:"manage-policies,omitempty"`
@@ -206,12 +238,20 @@ func (s *teams) Create(ctx context.Context, organization string, options TeamCre
 
 // Read a single team by its ID.
 func (s *teams) Read(ctx context.Context, teamID string) (*Team, error) {
+	return s.ReadWithOptions(ctx, teamID, nil)
+}
+
+// ReadWithOptions reads a team by its ID using the options supplied.
+func (s *teams) ReadWithOptions(ctx context.Context, teamID string, options *TeamReadOptions) (*Team, error) {
 	if !validStringID(&teamID) {
 		return nil, ErrInvalidTeamID
 	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
 
 	u := fmt.Sprintf("teams/%s", url.QueryEscape(teamID))
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequest("GET", u, options)
 	if err != nil {
 		return nil, err
 	}
@@ -261,6 +301,45 @@ func (s *teams) Delete(ctx context.Context, teamID string) error {
 	return req.Do(ctx, nil)
 }
 
+// UpdateSSOTeamID maps a team to an identity provider group for SAML/SSO
+// team membership sync.
+func (s *teams) UpdateSSOTeamID(ctx context.Context, teamID, ssoTeamID string) (*Team, error) {
+	if !validString(&ssoTeamID) {
+		return nil, ErrRequiredSSOTeamID
+	}
+
+	return s.updateSSOTeamID(ctx, teamID, teamSSOTeamIDOptions{
+		SSOTeamID: jsonapi.NewNullableAttrWithValue(ssoTeamID),
+	})
+}
+
+// ClearSSOTeamID removes the identity provider group mapping of a team.
+func (s *teams) ClearSSOTeamID(ctx context.Context, teamID string) (*Team, error) {
+	return s.updateSSOTeamID(ctx, teamID, teamSSOTeamIDOptions{
+		SSOTeamID: jsonapi.NewNullNullableAttr[string](),
+	})
+}
+
+func (s *teams) updateSSOTeamID(ctx context.Context, teamID string, options teamSSOTeamIDOptions) (*Team, error) {
+	if !validStringID(&teamID) {
+		return nil, ErrInvalidTeamID
+	}
+
+	u := fmt.Sprintf("teams/%s", url.QueryEscape(teamID))
+	req, err := s.client.NewRequest("PATCH", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Team{}
+	err = req.Do(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
 func (o TeamCreateOptions) valid() error {
 	if !validString(o.Name) {
 		return ErrRequiredName
@@ -280,6 +359,22 @@ func (o *TeamListOptions) valid() error {
 	return nil
 }
 
+func (o *TeamReadOptions) valid() error {
+	if o == nil {
+		return nil // nothing to validate
+	}
+
+	for _, include := range o.Include {
+		switch include {
+		case TeamUsers, TeamOrganizationMemberships:
+		default:
+			return ErrInvalidIncludeValue
+		}
+	}
+
+	return nil
+}
+
 func validateTeamNames(names []string) error {
 	for _, name := range names {
 		if name == "" {
@@ -32,6 +32,15 @@ type TeamAccesses interface {
 
 	// Remove team access from a workspace.
 	Remove(ctx context.Context, teamAccessID string) error
+
+	// ReadEffective resolves the access a team has on a workspace from both
+	// its direct workspace access and the access inherited from the
+	// workspace's project.
+	ReadEffective(ctx context.Context, teamID, workspaceID string) (*TeamWorkspaceEffectiveAccess, error)
+
+	// Apply reconciles the team accesses of a workspace with the desired
+	// ones, adding, updating and removing team accesses as needed.
+	Apply(ctx context.Context, workspaceID string, desired []TeamAccessSpec) ([]*TeamAccessOperation, error)
 }
 
 // teamAccesses implements TeamAccesses.
@@ -108,6 +117,72 @@ type TeamAccess struct {
 	Workspace *Workspace `jsonapi:"relation,workspace"`
 }
 
+// TeamAccessSource represents where a team's access on a workspace comes from.
+type TeamAccessSource string
+
+const (
+	TeamAccessSourceWorkspace TeamAccessSource = "workspace"
+	TeamAccessSourceProject   TeamAccessSource = "project"
+)
+
+// TeamWorkspaceEffectiveAccess represents the access a team has on a
+// workspace once direct and project-inherited access are combined. Each
+// permission is the highest level granted by any of the sources. When the
+// team has no access at all, Sources is empty and the permissions are unset.
+type TeamWorkspaceEffectiveAccess struct {
+	TeamID           string
+	WorkspaceID      string
+	Runs             RunsPermissionType
+	Variables        VariablesPermissionType
+	StateVersions    StateVersionsPermissionType
+	SentinelMocks    SentinelMocksPermissionType
+	WorkspaceLocking bool
+	RunTasks         bool
+
+	// The sources that contributed to the effective access.
+	Sources []TeamAccessSource
+
+	// The access records the effective access was resolved from, nil when
+	// the team has no access from that source.
+	WorkspaceAccess *TeamAccess
+	ProjectAccess   *TeamProjectAccess
+}
+
+// TeamAccessSpec represents the desired access of a team on a workspace.
+type TeamAccessSpec struct {
+	// Required: The ID of the team.
+	TeamID string
+
+	// Required: The type of access to grant.
+	Access AccessType
+
+	// Optional: Custom workspace access permissions, only used when Access
+	// is AccessCustom.
+	Runs             *RunsPermissionType
+	Variables        *VariablesPermissionType
+	StateVersions    *StateVersionsPermissionType
+	SentinelMocks    *SentinelMocksPermissionType
+	WorkspaceLocking *bool
+	RunTasks         *bool
+}
+
+// TeamAccessOperationType represents the kind of change made by Apply.
+type TeamAccessOperationType string
+
+const (
+	TeamAccessOperationAdd    TeamAccessOperationType = "add"
+	TeamAccessOperationUpdate TeamAccessOperationType = "update"
+	TeamAccessOperationRemove TeamAccessOperationType = "remove"
+)
+
+// TeamAccessOperation represents a change made by Apply. TeamAccess is the
+// resulting team access, or the removed one for removals.
+type TeamAccessOperation struct {
+	Type       TeamAccessOperationType
+	TeamID     string
+	TeamAccess *TeamAccess
+}
+
 // TeamAccessListOptions represents the options for listing team accesses.
 type TeamAccessListOptions struct {
 	ListOptions
@@ -259,6 +334,272 @@ func (s *teamAccesses) Remove(ctx context.Context, teamAccessID string) error {
 	return req.Do(ctx, nil)
 }
 
+// ReadEffective resolves the access a team has on a workspace.
+func (s *teamAccesses) ReadEffective(ctx context.Context, teamID, workspaceID string) (*TeamWorkspaceEffectiveAccess, error) {
+	if !validStringID(&teamID) {
+		return nil, ErrInvalidTeamID
+	}
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	w, err := s.client.Workspaces.ReadByID(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	ea := &TeamWorkspaceEffectiveAccess{
+		TeamID:      teamID,
+		WorkspaceID: workspaceID,
+	}
+
+	options := &TeamAccessListOptions{WorkspaceID: workspaceID}
+	for {
+		tal, err := s.List(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ta := range tal.Items {
+			if ta.Team != nil && ta.Team.ID == teamID {
+				ea.WorkspaceAccess = ta
+			}
+		}
+
+		if ea.WorkspaceAccess != nil || tal.Pagination == nil || tal.NextPage == 0 {
+			break
+		}
+		options.PageNumber = tal.NextPage
+	}
+
+	if w.Project != nil {
+		options := TeamProjectAccessListOptions{ProjectID: w.Project.ID}
+		for {
+			tpal, err := s.client.TeamProjectAccess.List(ctx, options)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, tpa := range tpal.Items {
+				if tpa.Team != nil && tpa.Team.ID == teamID {
+					ea.ProjectAccess = tpa
+				}
+			}
+
+			if ea.ProjectAccess != nil || tpal.Pagination == nil || tpal.NextPage == 0 {
+				break
+			}
+			options.PageNumber = tpal.NextPage
+		}
+	}
+
+	ea.resolve()
+
+	return ea, nil
+}
+
+// resolve computes the effective permissions from the access records.
+func (ea *TeamWorkspaceEffectiveAccess) resolve() {
+	if ta := ea.WorkspaceAccess; ta != nil {
+		ea.Sources = append(ea.Sources, TeamAccessSourceWorkspace)
+		ea.grant(ta.Runs, ta.Variables, ta.StateVersions, ta.SentinelMocks, ta.WorkspaceLocking, ta.RunTasks)
+	}
+
+	if tpa := ea.ProjectAccess; tpa != nil {
+		ea.Sources = append(ea.Sources, TeamAccessSourceProject)
+
+		switch tpa.Access {
+		case TeamProjectAccessAdmin, TeamProjectAccessMaintain:
+			ea.grant(RunsPermissionApply, VariablesPermissionWrite, StateVersionsPermissionWrite, SentinelMocksPermissionRead, true, true)
+		case TeamProjectAccessWrite:
+			ea.grant(RunsPermissionApply, VariablesPermissionWrite, StateVersionsPermissionWrite, SentinelMocksPermissionRead, true, false)
+		case TeamProjectAccessRead:
+			ea.grant(RunsPermissionRead, VariablesPermissionRead, StateVersionsPermissionRead, SentinelMocksPermissionNone, false, false)
+		case TeamProjectAccessCustom:
+			if wa := tpa.WorkspaceAccess; wa != nil {
+				ea.grant(
+					RunsPermissionType(wa.WorkspaceRunsPermission),
+					VariablesPermissionType(wa.WorkspaceVariablesPermission),
+					StateVersionsPermissionType(wa.WorkspaceStateVersionsPermission),
+					SentinelMocksPermissionType(wa.WorkspaceSentinelMocksPermission),
+					wa.WorkspaceLockingPermission,
+					wa.WorkspaceRunTasksPermission,
+				)
+			}
+		}
+	}
+}
+
+// grant raises each permission to the given level if it is higher.
+func (ea *TeamWorkspaceEffectiveAccess) grant(
+	runs RunsPermissionType,
+	variables VariablesPermissionType,
+	stateVersions StateVersionsPermissionType,
+	sentinelMocks SentinelMocksPermissionType,
+	locking, runTasks bool,
+) {
+	if runsPermissionRanks[runs] > runsPermissionRanks[ea.Runs] {
+		ea.Runs = runs
+	}
+	if variablesPermissionRanks[variables] > variablesPermissionRanks[ea.Variables] {
+		ea.Variables = variables
+	}
+	if stateVersionsPermissionRanks[stateVersions] > stateVersionsPermissionRanks[ea.StateVersions] {
+		ea.StateVersions = stateVersions
+	}
+	if sentinelMocksPermissionRanks[sentinelMocks] > sentinelMocksPermissionRanks[ea.SentinelMocks] {
+		ea.SentinelMocks = sentinelMocks
+	}
+	ea.WorkspaceLocking = ea.WorkspaceLocking || locking
+	ea.RunTasks = ea.RunTasks || runTasks
+}
+
+// The rank of each permission level, higher levels granting more access.
+// Unknown or unset levels rank lowest.
+var (
+	runsPermissionRanks = map[RunsPermissionType]int{
+		RunsPermissionRead:  1,
+		RunsPermissionPlan:  2,
+		RunsPermissionApply: 3,
+	}
+	variablesPermissionRanks = map[VariablesPermissionType]int{
+		VariablesPermissionNone:  1,
+		VariablesPermissionRead:  2,
+		VariablesPermissionWrite: 3,
+	}
+	stateVersionsPermissionRanks = map[StateVersionsPermissionType]int{
+		StateVersionsPermissionNone:        1,
+		StateVersionsPermissionReadOutputs: 2,
+		StateVersionsPermissionRead:        3,
+		StateVersionsPermissionWrite:       4,
+	}
+	sentinelMocksPermissionRanks = map[SentinelMocksPermissionType]int{
+		SentinelMocksPermissionNone: 1,
+		SentinelMocksPermissionRead: 2,
+	}
+)
+
+// Apply reconciles the team accesses of a workspace with the desired ones.
+// Missing team accesses are added and changed ones updated first, then the
+// team accesses of teams missing from desired are removed. The operations
+// performed are returned, also when an operation fails part way through.
+func (s *teamAccesses) Apply(ctx context.Context, workspaceID string, desired []TeamAccessSpec) ([]*TeamAccessOperation, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	for _, spec := range desired {
+		if err := spec.valid(); err != nil {
+			return nil, err
+		}
+		if wanted[spec.TeamID] {
+			return nil, ErrDuplicateTeamAccessSpec
+		}
+		wanted[spec.TeamID] = true
+	}
+
+	var current []*TeamAccess
+	options := &TeamAccessListOptions{WorkspaceID: workspaceID}
+	for {
+		tal, err := s.List(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		current = append(current, tal.Items...)
+
+		if tal.Pagination == nil || tal.NextPage == 0 {
+			break
+		}
+		options.PageNumber = tal.NextPage
+	}
+
+	existing := make(map[string]*TeamAccess, len(current))
+	for _, ta := range current {
+		if ta.Team != nil {
+			existing[ta.Team.ID] = ta
+		}
+	}
+
+	var ops []*TeamAccessOperation
+	for _, spec := range desired {
+		ta, ok := existing[spec.TeamID]
+		switch {
+		case !ok:
+			added, err := s.Add(ctx, TeamAccessAddOptions{
+				Access:           Access(spec.Access),
+				Runs:             spec.Runs,
+				Variables:        spec.Variables,
+				StateVersions:    spec.StateVersions,
+				SentinelMocks:    spec.SentinelMocks,
+				WorkspaceLocking: spec.WorkspaceLocking,
+				RunTasks:         spec.RunTasks,
+				Team:             &Team{ID: spec.TeamID},
+				Workspace:        &Workspace{ID: workspaceID},
+			})
+			if err != nil {
+				return ops, err
+			}
+			ops = append(ops, &TeamAccessOperation{Type: TeamAccessOperationAdd, TeamID: spec.TeamID, TeamAccess: added})
+		case !spec.matches(ta):
+			updated, err := s.Update(ctx, ta.ID, TeamAccessUpdateOptions{
+				Access:           Access(spec.Access),
+				Runs:             spec.Runs,
+				Variables:        spec.Variables,
+				StateVersions:    spec.StateVersions,
+				SentinelMocks:    spec.SentinelMocks,
+				WorkspaceLocking: spec.WorkspaceLocking,
+				RunTasks:         spec.RunTasks,
+			})
+			if err != nil {
+				return ops, err
+			}
+			ops = append(ops, &TeamAccessOperation{Type: TeamAccessOperationUpdate, TeamID: spec.TeamID, TeamAccess: updated})
+		}
+	}
+
+	for _, ta := range current {
+		if ta.Team == nil || wanted[ta.Team.ID] {
+			continue
+		}
+		if err := s.Remove(ctx, ta.ID); err != nil {
+			return ops, err
+		}
+		ops = append(ops, &TeamAccessOperation{Type: TeamAccessOperationRemove, TeamID: ta.Team.ID, TeamAccess: ta})
+	}
+
+	return ops, nil
+}
+
+func (spec TeamAccessSpec) valid() error {
+	if !validStringID(&spec.TeamID) {
+		return ErrInvalidTeamID
+	}
+	if spec.Access == "" {
+		return ErrRequiredAccess
+	}
+	return nil
+}
+
+// matches reports whether the team access already grants what the spec
+// describes. Custom permissions are only compared when the spec sets them.
+func (spec TeamAccessSpec) matches(ta *TeamAccess) bool {
+	if ta.Access != spec.Access {
+		return false
+	}
+	if spec.Access != AccessCustom {
+		return true
+	}
+
+	return (spec.Runs == nil || *spec.Runs == ta.Runs) &&
+		(spec.Variables == nil || *spec.Variables == ta.Variables) &&
+		(spec.StateVersions == nil || *spec.StateVersions == ta.StateVersions) &&
+		(spec.SentinelMocks == nil || *spec.SentinelMocks == ta.SentinelMocks) &&
+		(spec.WorkspaceLocking == nil || *spec.WorkspaceLocking == ta.WorkspaceLocking) &&
+		(spec.RunTasks == nil || *spec.RunTasks == ta.RunTasks)
+}
+
 func (o *TeamAccessListOptions) valid() error {
 	if o == nil {
 		return ErrRequiredTeamAccessListOps
@@ -5,6 +5,10 @@ package tfe
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -390,3 +394,270 @@ func TestTeamAccessesUpdateRunTasks(t *testing.T) {
 		assert.Equal(t, newAccess, ta.RunTasks)
 	})
 }
+
+func TestTeamAccessesReadEffective(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	pTest, pTestCleanup := createProject(t, client, orgTest)
+	defer pTestCleanup()
+
+	wTest, wTestCleanup := createWorkspaceWithOptions(t, client, orgTest, WorkspaceCreateOptions{
+		Name:    String(randomString(t)),
+		Project: pTest,
+	})
+	defer wTestCleanup()
+
+	tmTest, tmTestCleanup := createTeam(t, client, orgTest)
+	defer tmTestCleanup()
+
+	t.Run("without any access", func(t *testing.T) {
+		ea, err := client.TeamAccess.ReadEffective(ctx, tmTest.ID, wTest.ID)
+		require.NoError(t, err)
+
+		assert.Empty(t, ea.Sources)
+		assert.Nil(t, ea.WorkspaceAccess)
+		assert.Nil(t, ea.ProjectAccess)
+	})
+
+	t.Run("with direct workspace access", func(t *testing.T) {
+		ta, err := client.TeamAccess.Add(ctx, TeamAccessAddOptions{
+			Access:    Access(AccessRead),
+			Team:      tmTest,
+			Workspace: wTest,
+		})
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, client.TeamAccess.Remove(ctx, ta.ID))
+		}()
+
+		ea, err := client.TeamAccess.ReadEffective(ctx, tmTest.ID, wTest.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, []TeamAccessSource{TeamAccessSourceWorkspace}, ea.Sources)
+		assert.Equal(t, ta.ID, ea.WorkspaceAccess.ID)
+		assert.Equal(t, RunsPermissionRead, ea.Runs)
+
+		t.Run("and inherited project access", func(t *testing.T) {
+			tpa, tpaCleanup := createTeamProjectAccess(t, client, tmTest, pTest, orgTest)
+			defer tpaCleanup()
+
+			ea, err := client.TeamAccess.ReadEffective(ctx, tmTest.ID, wTest.ID)
+			require.NoError(t, err)
+
+			assert.Equal(t, []TeamAccessSource{TeamAccessSourceWorkspace, TeamAccessSourceProject}, ea.Sources)
+			assert.Equal(t, tpa.ID, ea.ProjectAccess.ID)
+			assert.Equal(t, RunsPermissionApply, ea.Runs)
+			assert.Equal(t, VariablesPermissionWrite, ea.Variables)
+			assert.Equal(t, StateVersionsPermissionWrite, ea.StateVersions)
+			assert.True(t, ea.WorkspaceLocking)
+		})
+	})
+
+	t.Run("with an invalid team ID", func(t *testing.T) {
+		ea, err := client.TeamAccess.ReadEffective(ctx, badIdentifier, wTest.ID)
+		assert.Nil(t, ea)
+		assert.Equal(t, ErrInvalidTeamID, err)
+	})
+
+	t.Run("with an invalid workspace ID", func(t *testing.T) {
+		ea, err := client.TeamAccess.ReadEffective(ctx, tmTest.ID, badIdentifier)
+		assert.Nil(t, ea)
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+	})
+}
+
+func TestTeamWorkspaceEffectiveAccess_resolve(t *testing.T) {
+	t.Run("takes the highest level of each permission", func(t *testing.T) {
+		ea := &TeamWorkspaceEffectiveAccess{
+			WorkspaceAccess: &TeamAccess{
+				Access:           AccessCustom,
+				Runs:             RunsPermissionPlan,
+				Variables:        VariablesPermissionWrite,
+				StateVersions:    StateVersionsPermissionReadOutputs,
+				SentinelMocks:    SentinelMocksPermissionNone,
+				WorkspaceLocking: true,
+			},
+			ProjectAccess: &TeamProjectAccess{
+				Access: TeamProjectAccessCustom,
+				WorkspaceAccess: &TeamProjectAccessWorkspacePermissions{
+					WorkspaceRunsPermission:          WorkspaceRunsPermissionRead,
+					WorkspaceVariablesPermission:     WorkspaceVariablesPermissionRead,
+					WorkspaceStateVersionsPermission: WorkspaceStateVersionsPermissionRead,
+					WorkspaceSentinelMocksPermission: WorkspaceSentinelMocksPermissionRead,
+					WorkspaceRunTasksPermission:      true,
+				},
+			},
+		}
+		ea.resolve()
+
+		assert.Equal(t, []TeamAccessSource{TeamAccessSourceWorkspace, TeamAccessSourceProject}, ea.Sources)
+		assert.Equal(t, RunsPermissionPlan, ea.Runs)
+		assert.Equal(t, VariablesPermissionWrite, ea.Variables)
+		assert.Equal(t, StateVersionsPermissionRead, ea.StateVersions)
+		assert.Equal(t, SentinelMocksPermissionRead, ea.SentinelMocks)
+		assert.True(t, ea.WorkspaceLocking)
+		assert.True(t, ea.RunTasks)
+	})
+
+	t.Run("with project maintain access", func(t *testing.T) {
+		ea := &TeamWorkspaceEffectiveAccess{
+			ProjectAccess: &TeamProjectAccess{Access: TeamProjectAccessMaintain},
+		}
+		ea.resolve()
+
+		assert.Equal(t, []TeamAccessSource{TeamAccessSourceProject}, ea.Sources)
+		assert.Equal(t, RunsPermissionApply, ea.Runs)
+		assert.Equal(t, VariablesPermissionWrite, ea.Variables)
+		assert.Equal(t, StateVersionsPermissionWrite, ea.StateVersions)
+		assert.Equal(t, SentinelMocksPermissionRead, ea.SentinelMocks)
+	})
+
+	t.Run("without any access", func(t *testing.T) {
+		ea := &TeamWorkspaceEffectiveAccess{}
+		ea.resolve()
+
+		assert.Empty(t, ea.Sources)
+		assert.Empty(t, ea.Runs)
+	})
+}
+
+func TestTeamAccessesApply(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	wTest, wTestCleanup := createWorkspace(t, client, orgTest)
+	defer wTestCleanup()
+
+	tmKeep, tmKeepCleanup := createTeam(t, client, orgTest)
+	defer tmKeepCleanup()
+	tmChange, tmChangeCleanup := createTeam(t, client, orgTest)
+	defer tmChangeCleanup()
+	tmRemove, tmRemoveCleanup := createTeam(t, client, orgTest)
+	defer tmRemoveCleanup()
+	tmAdd, tmAddCleanup := createTeam(t, client, orgTest)
+	defer tmAddCleanup()
+
+	for _, tm := range []*Team{tmKeep, tmChange, tmRemove} {
+		_, err := client.TeamAccess.Add(ctx, TeamAccessAddOptions{
+			Access:    Access(AccessRead),
+			Team:      tm,
+			Workspace: wTest,
+		})
+		require.NoError(t, err)
+	}
+
+	desired := []TeamAccessSpec{
+		{TeamID: tmKeep.ID, Access: AccessRead},
+		{TeamID: tmChange.ID, Access: AccessCustom, Runs: RunsPermission(RunsPermissionPlan)},
+		{TeamID: tmAdd.ID, Access: AccessWrite},
+	}
+
+	t.Run("reconciles the team accesses", func(t *testing.T) {
+		ops, err := client.TeamAccess.Apply(ctx, wTest.ID, desired)
+		require.NoError(t, err)
+		require.Len(t, ops, 3)
+
+		assert.Equal(t, TeamAccessOperationUpdate, ops[0].Type)
+		assert.Equal(t, tmChange.ID, ops[0].TeamID)
+		assert.Equal(t, RunsPermissionPlan, ops[0].TeamAccess.Runs)
+		assert.Equal(t, TeamAccessOperationAdd, ops[1].Type)
+		assert.Equal(t, tmAdd.ID, ops[1].TeamID)
+		assert.Equal(t, TeamAccessOperationRemove, ops[2].Type)
+		assert.Equal(t, tmRemove.ID, ops[2].TeamID)
+
+		tal, err := client.TeamAccess.List(ctx, &TeamAccessListOptions{WorkspaceID: wTest.ID})
+		require.NoError(t, err)
+		assert.Len(t, tal.Items, 3)
+	})
+
+	t.Run("when already reconciled", func(t *testing.T) {
+		ops, err := client.TeamAccess.Apply(ctx, wTest.ID, desired)
+		require.NoError(t, err)
+		assert.Empty(t, ops)
+	})
+
+	t.Run("with a duplicate team", func(t *testing.T) {
+		ops, err := client.TeamAccess.Apply(ctx, wTest.ID, []TeamAccessSpec{
+			{TeamID: tmKeep.ID, Access: AccessRead},
+			{TeamID: tmKeep.ID, Access: AccessAdmin},
+		})
+		assert.Nil(t, ops)
+		assert.Equal(t, ErrDuplicateTeamAccessSpec, err)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		ops, err := client.TeamAccess.Apply(ctx, badIdentifier, desired)
+		assert.Nil(t, ops)
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+	})
+}
+
+func TestTeamAccessesApply_Operations(t *testing.T) {
+	ctx := context.Background()
+
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(204)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/team-workspaces":
+			w.Write([]byte(`{"data":[
+				{"type":"team-workspaces","id":"tws-a","attributes":{"access":"read"},"relationships":{"team":{"data":{"type":"teams","id":"team-a"}}}},
+				{"type":"team-workspaces","id":"tws-b","attributes":{"access":"custom","runs":"read"},"relationships":{"team":{"data":{"type":"teams","id":"team-b"}}}},
+				{"type":"team-workspaces","id":"tws-c","attributes":{"access":"write"},"relationships":{"team":{"data":{"type":"teams","id":"team-c"}}}}]}`))
+		case r.Method == "POST":
+			w.WriteHeader(201)
+			w.Write([]byte(`{"data":{"type":"team-workspaces","id":"tws-d","attributes":{"access":"admin"}}}`))
+		case r.Method == "PATCH":
+			w.Write([]byte(`{"data":{"type":"team-workspaces","id":"tws-b","attributes":{"access":"custom","runs":"apply"}}}`))
+		case r.Method == "DELETE":
+			w.WriteHeader(204)
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "abcd1234",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	ops, err := client.TeamAccess.Apply(ctx, "ws-1", []TeamAccessSpec{
+		{TeamID: "team-a", Access: AccessRead},
+		{TeamID: "team-b", Access: AccessCustom, Runs: RunsPermission(RunsPermissionApply)},
+		{TeamID: "team-d", Access: AccessAdmin},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, ops, 3)
+	assert.Equal(t, &TeamAccessOperation{Type: TeamAccessOperationUpdate, TeamID: "team-b", TeamAccess: ops[0].TeamAccess}, ops[0])
+	assert.Equal(t, RunsPermissionApply, ops[0].TeamAccess.Runs)
+	assert.Equal(t, &TeamAccessOperation{Type: TeamAccessOperationAdd, TeamID: "team-d", TeamAccess: ops[1].TeamAccess}, ops[1])
+	assert.Equal(t, "tws-d", ops[1].TeamAccess.ID)
+	assert.Equal(t, &TeamAccessOperation{Type: TeamAccessOperationRemove, TeamID: "team-c", TeamAccess: ops[2].TeamAccess}, ops[2])
+	assert.Equal(t, "tws-c", ops[2].TeamAccess.ID)
+
+	require.Len(t, requests, 4)
+	assert.Contains(t, requests[1], "PATCH /api/v2/team-workspaces/tws-b")
+	assert.Contains(t, requests[1], `"runs":"apply"`)
+	assert.Contains(t, requests[2], "POST /api/v2/team-workspaces")
+	assert.Contains(t, requests[2], `"team":{"data":{"type":"teams","id":"team-d"}}`)
+	assert.Contains(t, requests[3], "DELETE /api/v2/team-workspaces/tws-c")
+}
@@ -196,6 +196,57 @@ func TestTeamsRead(t *testing.T) {
 	})
 }
 
+func TestTeamsReadWithOptions(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	tmTest, tmTestCleanup := createTeam(t, client, orgTest)
+	defer tmTestCleanup()
+
+	testAcct := fetchTestAccountDetails(t, client)
+	err := client.TeamMembers.Add(ctx, tmTest.ID, TeamMemberAddOptions{
+		Usernames: []string{testAcct.Username},
+	})
+	require.NoError(t, err)
+
+	t.Run("with users and organization memberships included", func(t *testing.T) {
+		tm, err := client.Teams.ReadWithOptions(ctx, tmTest.ID, &TeamReadOptions{
+			Include: []TeamIncludeOpt{TeamUsers, TeamOrganizationMemberships},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, tm.OrganizationAccess)
+
+		require.Len(t, tm.Users, 1)
+		assert.Equal(t, testAcct.Username, tm.Users[0].Username)
+
+		require.Len(t, tm.OrganizationMemberships, 1)
+		assert.NotEmpty(t, tm.OrganizationMemberships[0].Status)
+	})
+
+	t.Run("without options", func(t *testing.T) {
+		tm, err := client.Teams.ReadWithOptions(ctx, tmTest.ID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, tmTest.ID, tm.ID)
+	})
+
+	t.Run("with an invalid include", func(t *testing.T) {
+		tm, err := client.Teams.ReadWithOptions(ctx, tmTest.ID, &TeamReadOptions{
+			Include: []TeamIncludeOpt{"organization-entitlements"},
+		})
+		assert.Nil(t, tm)
+		assert.Equal(t, err, ErrInvalidIncludeValue)
+	})
+
+	t.Run("without a valid team ID", func(t *testing.T) {
+		tm, err := client.Teams.ReadWithOptions(ctx, badIdentifier, nil)
+		assert.Nil(t, tm)
+		assert.Equal(t, err, ErrInvalidTeamID)
+	})
+}
+
 func TestTeamsUpdate(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -272,6 +323,51 @@ func TestTeamsUpdate(t *testing.T) {
 	})
 }
 
+func TestTeamsUpdateSSOTeamID(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	tmTest, tmTestCleanup := createTeam(t, client, orgTest)
+	defer tmTestCleanup()
+
+	t.Run("with a valid SSO team ID", func(t *testing.T) {
+		ssoTeamID := randomString(t)
+
+		tm, err := client.Teams.UpdateSSOTeamID(ctx, tmTest.ID, ssoTeamID)
+		require.NoError(t, err)
+		assert.Equal(t, ssoTeamID, tm.SSOTeamID)
+
+		refreshed, err := client.Teams.Read(ctx, tmTest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, ssoTeamID, refreshed.SSOTeamID)
+	})
+
+	t.Run("when clearing the SSO team ID", func(t *testing.T) {
+		tm, err := client.Teams.ClearSSOTeamID(ctx, tmTest.ID)
+		require.NoError(t, err)
+		assert.Empty(t, tm.SSOTeamID)
+
+		refreshed, err := client.Teams.Read(ctx, tmTest.ID)
+		require.NoError(t, err)
+		assert.Empty(t, refreshed.SSOTeamID)
+	})
+
+	t.Run("with an empty SSO team ID", func(t *testing.T) {
+		tm, err := client.Teams.UpdateSSOTeamID(ctx, tmTest.ID, "")
+		assert.Nil(t, tm)
+		assert.Equal(t, err, ErrRequiredSSOTeamID)
+	})
+
+	t.Run("without a valid team ID", func(t *testing.T) {
+		tm, err := client.Teams.ClearSSOTeamID(ctx, badIdentifier)
+		assert.Nil(t, tm)
+		assert.Equal(t, err, ErrInvalidTeamID)
+	})
+}
+
 func TestTeamsDelete(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -266,9 +266,10 @@ func (s *teamProjectAccesses) Update(ctx context.Context, teamProjectAccessID st
 		return nil, ErrInvalidTeamProjectAccessID
 	}
 
-	if err := validateTeamProjectAccessType(*options.Access); err != nil {
+	if err := options.valid(); err != nil {
 		return nil, err
 	}
+
 	u := fmt.Sprintf("team-projects/%s", url.QueryEscape(teamProjectAccessID))
 	req, err := s.client.NewRequest("PATCH", u, &options)
 	if err != nil {
@@ -318,6 +319,27 @@ func (o TeamProjectAccessAddOptions) valid() error {
 		return ErrRequiredProject
 	}
 
+	return validateTeamProjectAccessPermissions(o.Access, o.ProjectAccess, o.WorkspaceAccess)
+}
+
+func (o TeamProjectAccessUpdateOptions) valid() error {
+	if o.Access == nil {
+		return nil
+	}
+	if err := validateTeamProjectAccessType(*o.Access); err != nil {
+		return err
+	}
+
+	return validateTeamProjectAccessPermissions(*o.Access, o.ProjectAccess, o.WorkspaceAccess)
+}
+
+// validateTeamProjectAccessPermissions checks that the fine-grained project
+// and workspace permissions are only given along with custom access, as the
+// other access types grant a fixed set of permissions.
+func validateTeamProjectAccessPermissions(t TeamProjectAccessType, project *TeamProjectAccessProjectPermissionsOptions, workspace *TeamProjectAccessWorkspacePermissionsOptions) error {
+	if t != TeamProjectAccessCustom && (project != nil || workspace != nil) {
+		return ErrUnsupportedCustomPermissions
+	}
 	return nil
 }
 
@@ -322,6 +322,19 @@ func TestTeamProjectAccessesAdd(t *testing.T) {
 		assert.Nil(t, tpa)
 		assert.Equal(t, err, ErrInvalidTeamProjectAccessType)
 	})
+
+	t.Run("when custom permissions are provided without custom access", func(t *testing.T) {
+		tpa, err := client.TeamProjectAccess.Add(ctx, TeamProjectAccessAddOptions{
+			Access:  *ProjectAccess(TeamProjectAccessWrite),
+			Team:    tmTest,
+			Project: pTest,
+			WorkspaceAccess: &TeamProjectAccessWorkspacePermissionsOptions{
+				Runs: WorkspaceRunsPermission(WorkspaceRunsPermissionApply),
+			},
+		})
+		assert.Nil(t, tpa)
+		assert.Equal(t, err, ErrUnsupportedCustomPermissions)
+	})
 }
 
 func TestTeamProjectAccessesUpdate(t *testing.T) {
@@ -442,6 +455,77 @@ func TestTeamProjectAccessesUpdate(t *testing.T) {
 		assert.Nil(t, tpa)
 		assert.Error(t, err)
 	})
+
+	t.Run("with custom permissions attributes without custom access", func(t *testing.T) {
+		options := TeamProjectAccessUpdateOptions{
+			Access: ProjectAccess(TeamProjectAccessMaintain),
+			ProjectAccess: &TeamProjectAccessProjectPermissionsOptions{
+				Teams: ProjectTeamsPermission(ProjectTeamsPermissionManage),
+			},
+		}
+
+		tpa, err := client.TeamProjectAccess.Update(ctx, tpaTest.ID, options)
+
+		assert.Nil(t, tpa)
+		assert.Equal(t, err, ErrUnsupportedCustomPermissions)
+	})
+}
+
+func TestTeamProjectAccessUpdateOptionsValid(t *testing.T) {
+	t.Run("without access", func(t *testing.T) {
+		options := TeamProjectAccessUpdateOptions{
+			WorkspaceAccess: &TeamProjectAccessWorkspacePermissionsOptions{
+				Locking: Bool(true),
+			},
+		}
+
+		err := options.valid()
+		assert.Nil(t, err)
+	})
+
+	t.Run("with custom access and permissions", func(t *testing.T) {
+		options := TeamProjectAccessUpdateOptions{
+			Access: ProjectAccess(TeamProjectAccessCustom),
+			ProjectAccess: &TeamProjectAccessProjectPermissionsOptions{
+				Settings: ProjectSettingsPermission(ProjectSettingsPermissionUpdate),
+			},
+			WorkspaceAccess: &TeamProjectAccessWorkspacePermissionsOptions{
+				Create: Bool(true),
+				Move:   Bool(true),
+			},
+		}
+
+		err := options.valid()
+		assert.Nil(t, err)
+	})
+
+	t.Run("with coarse access and permissions", func(t *testing.T) {
+		for _, access := range []TeamProjectAccessType{
+			TeamProjectAccessAdmin,
+			TeamProjectAccessMaintain,
+			TeamProjectAccessWrite,
+			TeamProjectAccessRead,
+		} {
+			options := TeamProjectAccessUpdateOptions{
+				Access: ProjectAccess(access),
+				WorkspaceAccess: &TeamProjectAccessWorkspacePermissionsOptions{
+					Create: Bool(true),
+				},
+			}
+
+			err := options.valid()
+			assert.Equal(t, ErrUnsupportedCustomPermissions, err, access)
+		}
+	})
+
+	t.Run("with an invalid access", func(t *testing.T) {
+		options := TeamProjectAccessUpdateOptions{
+			Access: ProjectAccess(badIdentifier),
+		}
+
+		err := options.valid()
+		assert.Equal(t, ErrInvalidTeamProjectAccessType, err)
+	})
 }
 
 func TestTeamProjectAccessesRemove(t *testing.T) {
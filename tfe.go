@@ -43,6 +43,9 @@ const (
 	DefaultAddress      = "https://app.terraform.io"
 	DefaultBasePath     = "/api/v2/"
 	DefaultRegistryPath = "/api/registry/"
+	// DefaultMaxIncludeDepth is the default maximum number of relations in
+	// an include path, e.g. "workspace.organization.owners" is three deep.
+	DefaultMaxIncludeDepth = 3
 	// PingEndpoint is a no-op API endpoint used to configure the rate limiter
 	PingEndpoint       = "ping"
 	ContentTypeJSONAPI = "application/vnd.api+json"
@@ -52,6 +55,27 @@ const (
 
 type RetryLogHook func(attemptNum int, resp *http.Response)
 
+// defaultRetryMaxWait is the longest a retry waits for when the retry policy
+// does not set MaxWait.
+const defaultRetryMaxWait = time.Minute
+
+// RetryPolicy configures the retries of requests that failed with a rate limit
+// (429) or service unavailable (503) response.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a request is retried.
+	// Defaults to 30 when nil; set it to Int(0) to disable retries.
+	MaxRetries *int
+
+	// MaxWait caps how long a single retry waits for, however long the
+	// Retry-After header asks for. Defaults to 1 minute.
+	MaxWait time.Duration
+
+	// IdempotentOnly restricts retries to idempotent requests (GET, HEAD,
+	// OPTIONS, PUT and DELETE), so that requests such as POSTs that create
+	// resources are never sent twice.
+	IdempotentOnly bool
+}
+
 // Config provides configuration details to the API client.
 
 type Config struct {
@@ -78,6 +102,30 @@ type Config struct {
 
 	// RetryServerErrors enables the retry logic in the client.
 	RetryServerErrors bool
+
+	// RetryPolicy configures how rate limited requests, and requests to a
+	// temporarily unavailable server, are retried. When set, the client
+	// waits as long as the Retry-After header of those responses asks for.
+	RetryPolicy *RetryPolicy
+
+	// MaxIncludeDepth is the maximum number of relations in an include path.
+	// Requests including deeper paths fail with ErrInvalidIncludeValue
+	// before being sent. Defaults to DefaultMaxIncludeDepth.
+	MaxIncludeDepth int
+
+	// AppName identifies the application using the client. When set, it is
+	// prepended to the User-Agent header, e.g. "myapp/1.2.3 go-tfe".
+	AppName string
+
+	// AppVersion is the version of the application using the client. It
+	// requires AppName to be set.
+	AppVersion string
+
+	// PlanCacheSize is the number of plans Plans.Read and
+	// Plans.ReadWithOptions keep in memory along with their ETag. When a
+	// cached plan has not changed since, it is returned without being sent
+	// again by the server. Defaults to 0, which disables the cache.
+	PlanCacheSize int
 }
 
 // DefaultConfig returns a default config structure.
@@ -91,6 +139,7 @@ func DefaultConfig() *Config {
 		Headers:           make(http.Header),
 		HTTPClient:        cleanhttp.DefaultPooledClient(),
 		RetryServerErrors: false,
+		MaxIncludeDepth:   DefaultMaxIncludeDepth,
 	}
 
 	// Set the default address if none is given.
@@ -119,9 +168,12 @@ type Client struct {
 	limiter           *rate.Limiter
 	retryLogHook      RetryLogHook
 	retryServerErrors bool
+	retryPolicy       *RetryPolicy
+	maxIncludeDepth   int
 	remoteAPIVersion  string
 	remoteTFEVersion  string
 	appName           string
+	planCacheSize     int
 
 	Admin                      Admin
 	Agents                     Agents
@@ -240,6 +292,34 @@ func (c *Client) doForeignPUTRequest(ctx context.Context, foreignURL string, dat
 	return request.DoJSON(ctx, nil)
 }
 
+// doForeignGETRequest performs a GET request to the specified URL and copies
+// the response body into the given writer. No Authentication header is sent.
+func (c *Client) doForeignGETRequest(ctx context.Context, foreignURL string, w io.Writer) error {
+	u, err := url.Parse(foreignURL)
+	if err != nil {
+		return fmt.Errorf("specified URL was not valid: %w", err)
+	}
+
+	req, err := retryablehttp.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	// Set the default headers.
+	for k, v := range c.headers {
+		req.Header[k] = v
+	}
+	req.Header.Set("Accept", "application/json, */*")
+
+	request := &ClientRequest{
+		retryableRequest: req,
+		http:             c.http,
+		Header:           req.Header,
+	}
+
+	return request.DoJSON(ctx, w)
+}
+
 func (c *Client) NewRequest(method, path string, reqAttr any) (*ClientRequest, error) {
 	return c.NewRequestWithAdditionalQueryParams(method, path, reqAttr, nil)
 }
@@ -276,6 +356,9 @@ func (c *Client) NewRequestWithAdditionalQueryParams(method, path string, reqAtt
 			for k, v := range additionalQueryParams {
 				q[k] = v
 			}
+			if err := c.validIncludes(q[_includeQueryParam]); err != nil {
+				return nil, err
+			}
 			u.RawQuery = encodeQueryParams(q)
 		}
 	case "DELETE", "PATCH", "POST":
@@ -316,6 +399,25 @@ func (c *Client) NewRequestWithAdditionalQueryParams(method, path string, reqAtt
 	}, nil
 }
 
+// validIncludes checks that every include path is well-formed and no deeper
+// than the configured maximum, reporting the first offending path.
+func (c *Client) validIncludes(includes []string) error {
+	maxDepth := c.maxIncludeDepth
+	if maxDepth <= 0 {
+		maxDepth = DefaultMaxIncludeDepth
+	}
+
+	for _, include := range includes {
+		for _, path := range strings.Split(include, ",") {
+			if !validIncludePath(path, maxDepth) {
+				return fmt.Errorf("%w: %q", ErrInvalidIncludeValue, path)
+			}
+		}
+	}
+
+	return nil
+}
+
 // NewClient creates a new Terraform Enterprise API client.
 func NewClient(cfg *Config) (*Client, error) {
 	config := DefaultConfig()
@@ -344,6 +446,22 @@ func NewClient(cfg *Config) (*Client, error) {
 			config.RetryLogHook = cfg.RetryLogHook
 		}
 		config.RetryServerErrors = cfg.RetryServerErrors
+		config.RetryPolicy = cfg.RetryPolicy
+		if cfg.MaxIncludeDepth > 0 {
+			config.MaxIncludeDepth = cfg.MaxIncludeDepth
+		}
+		config.AppName = cfg.AppName
+		config.AppVersion = cfg.AppVersion
+		config.PlanCacheSize = cfg.PlanCacheSize
+	}
+
+	// Identify the application in the user agent, keeping the library's.
+	if config.AppName != "" || config.AppVersion != "" {
+		product, err := userAgentProduct(config.AppName, config.AppVersion)
+		if err != nil {
+			return nil, err
+		}
+		config.Headers.Set("User-Agent", product+" "+config.Headers.Get("User-Agent"))
 	}
 
 	// Parse the address to make sure its a valid URL.
@@ -372,6 +490,19 @@ func NewClient(cfg *Config) (*Client, error) {
 		return nil, fmt.Errorf("missing API token")
 	}
 
+	retryMax := 30
+	if config.RetryPolicy != nil {
+		if config.RetryPolicy.MaxRetries != nil {
+			if *config.RetryPolicy.MaxRetries < 0 {
+				return nil, fmt.Errorf("invalid retry policy: max retries must not be negative")
+			}
+			retryMax = *config.RetryPolicy.MaxRetries
+		}
+		if config.RetryPolicy.MaxWait < 0 {
+			return nil, fmt.Errorf("invalid retry policy: max wait must not be negative")
+		}
+	}
+
 	// Create the client.
 	client := &Client{
 		baseURL:           baseURL,
@@ -380,6 +511,9 @@ func NewClient(cfg *Config) (*Client, error) {
 		headers:           config.Headers,
 		retryLogHook:      config.RetryLogHook,
 		retryServerErrors: config.RetryServerErrors,
+		retryPolicy:       config.RetryPolicy,
+		maxIncludeDepth:   config.MaxIncludeDepth,
+		planCacheSize:     config.PlanCacheSize,
 	}
 
 	client.http = &retryablehttp.Client{
@@ -389,102 +523,154 @@ func NewClient(cfg *Config) (*Client, error) {
 		HTTPClient:   config.HTTPClient,
 		RetryWaitMin: 100 * time.Millisecond,
 		RetryWaitMax: 400 * time.Millisecond,
-		RetryMax:     30,
+		RetryMax:     retryMax,
 	}
 
-	meta, err := client.getRawAPIMetadata()
+	if err := client.readAPIMetadata(); err != nil {
+		return nil, err
+	}
+
+	client.initServices()
+
+	return client, nil
+}
+
+// WithBaseURL returns a copy of the client that sends its requests to the
+// instance at address instead, keeping the base paths, token, headers and
+// retry settings of the client. The copy shares its HTTP client, and so its
+// connection pool, with the client. Like NewClient, it reads the metadata of
+// the instance to configure its own rate limiter.
+func (c *Client) WithBaseURL(address string) (*Client, error) {
+	baseURL, err := url.Parse(address)
 	if err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+
+	registryURL := *baseURL
+	baseURL.Path = c.baseURL.Path
+	registryURL.Path = c.registryBaseURL.Path
+
+	client := &Client{
+		baseURL:           baseURL,
+		registryBaseURL:   &registryURL,
+		token:             c.token,
+		headers:           c.headers.Clone(),
+		http:              c.http,
+		retryLogHook:      c.retryLogHook,
+		retryServerErrors: c.retryServerErrors,
+		retryPolicy:       c.retryPolicy,
+		maxIncludeDepth:   c.maxIncludeDepth,
+		planCacheSize:     c.planCacheSize,
+	}
+
+	if err := client.readAPIMetadata(); err != nil {
 		return nil, err
 	}
 
+	client.initServices()
+
+	return client, nil
+}
+
+// readAPIMetadata reads the metadata of the instance, configuring the rate
+// limiter and saving the versions and name of the instance.
+func (c *Client) readAPIMetadata() error {
+	meta, err := c.getRawAPIMetadata()
+	if err != nil {
+		return err
+	}
+
 	// Configure the rate limiter.
-	client.configureLimiter(meta.RateLimit)
+	c.configureLimiter(meta.RateLimit)
 
 	// Save the API version so we can return it from the RemoteAPIVersion
 	// method later.
-	client.remoteAPIVersion = meta.APIVersion
+	c.remoteAPIVersion = meta.APIVersion
 
 	// Save the TFE version
-	client.remoteTFEVersion = meta.TFEVersion
+	c.remoteTFEVersion = meta.TFEVersion
 
 	// Save the app name
-	client.appName = meta.AppName
+	c.appName = meta.AppName
 
+	return nil
+}
+
+// initServices creates the services of the client.
+func (c *Client) initServices() {
 	// Create Admin
-	client.Admin = Admin{
-		Organizations:     &adminOrganizations{client: client},
-		Workspaces:        &adminWorkspaces{client: client},
-		Runs:              &adminRuns{client: client},
-		Settings:          newAdminSettings(client),
-		TerraformVersions: &adminTerraformVersions{client: client},
-		OPAVersions:       &adminOPAVersions{client: client},
-		SentinelVersions:  &adminSentinelVersions{client: client},
-		Users:             &adminUsers{client: client},
+	c.Admin = Admin{
+		Organizations:     &adminOrganizations{client: c},
+		Workspaces:        &adminWorkspaces{client: c},
+		Runs:              &adminRuns{client: c},
+		Settings:          newAdminSettings(c),
+		TerraformVersions: &adminTerraformVersions{client: c},
+		OPAVersions:       &adminOPAVersions{client: c},
+		SentinelVersions:  &adminSentinelVersions{client: c},
+		Users:             &adminUsers{client: c},
 	}
 
 	// Create the services.
-	client.AgentPools = &agentPools{client: client}
-	client.Agents = &agents{client: client}
-	client.AgentTokens = &agentTokens{client: client}
-	client.Applies = &applies{client: client}
-	client.AuditTrails = &auditTrails{client: client}
-	client.Comments = &comments{client: client}
-	client.ConfigurationVersions = &configurationVersions{client: client}
-	client.GHAInstallations = &gHAInstallations{client: client}
-	client.CostEstimates = &costEstimates{client: client}
-	client.GPGKeys = &gpgKeys{client: client}
-	client.RegistryNoCodeModules = &registryNoCodeModules{client: client}
-	client.NotificationConfigurations = &notificationConfigurations{client: client}
-	client.OAuthClients = &oAuthClients{client: client}
-	client.OAuthTokens = &oAuthTokens{client: client}
-	client.OrganizationMemberships = &organizationMemberships{client: client}
-	client.Organizations = &organizations{client: client}
-	client.OrganizationTags = &organizationTags{client: client}
-	client.OrganizationTokens = &organizationTokens{client: client}
-	client.PlanExports = &planExports{client: client}
-	client.Plans = &plans{client: client}
-	client.Policies = &policies{client: client}
-	client.PolicyChecks = &policyChecks{client: client}
-	client.PolicyEvaluations = &policyEvaluation{client: client}
-	client.PolicySetOutcomes = &policySetOutcome{client: client}
-	client.PolicySetParameters = &policySetParameters{client: client}
-	client.PolicySets = &policySets{client: client}
-	client.PolicySetVersions = &policySetVersions{client: client}
-	client.Projects = &projects{client: client}
-	client.RegistryModules = &registryModules{client: client}
-	client.RegistryProviderPlatforms = &registryProviderPlatforms{client: client}
-	client.RegistryProviders = &registryProviders{client: client}
-	client.RegistryProviderVersions = &registryProviderVersions{client: client}
-	client.Runs = &runs{client: client}
-	client.RunEvents = &runEvents{client: client}
-	client.RunTasks = &runTasks{client: client}
-	client.RunTriggers = &runTriggers{client: client}
-	client.SSHKeys = &sshKeys{client: client}
-	client.StateVersionOutputs = &stateVersionOutputs{client: client}
-	client.StateVersions = &stateVersions{client: client}
-	client.TaskResults = &taskResults{client: client}
-	client.TaskStages = &taskStages{client: client}
-	client.TeamAccess = &teamAccesses{client: client}
-	client.TeamMembers = &teamMembers{client: client}
-	client.TeamProjectAccess = &teamProjectAccesses{client: client}
-	client.Teams = &teams{client: client}
-	client.TeamTokens = &teamTokens{client: client}
-	client.TestRuns = &testRuns{client: client}
-	client.TestVariables = &testVariables{client: client}
-	client.Users = &users{client: client}
-	client.UserTokens = &userTokens{client: client}
-	client.Variables = &variables{client: client}
-	client.VariableSets = &variableSets{client: client}
-	client.VariableSetVariables = &variableSetVariables{client: client}
-	client.WorkspaceRunTasks = &workspaceRunTasks{client: client}
-	client.Workspaces = &workspaces{client: client}
-	client.WorkspaceResources = &workspaceResources{client: client}
-
-	client.Meta = Meta{
-		IPRanges: &ipRanges{client: client},
+	c.AgentPools = &agentPools{client: c}
+	c.Agents = &agents{client: c}
+	c.AgentTokens = &agentTokens{client: c}
+	c.Applies = &applies{client: c}
+	c.AuditTrails = &auditTrails{client: c}
+	c.Comments = &comments{client: c}
+	c.ConfigurationVersions = &configurationVersions{client: c}
+	c.GHAInstallations = &gHAInstallations{client: c}
+	c.CostEstimates = &costEstimates{client: c}
+	c.GPGKeys = &gpgKeys{client: c}
+	c.RegistryNoCodeModules = &registryNoCodeModules{client: c}
+	c.NotificationConfigurations = &notificationConfigurations{client: c}
+	c.OAuthClients = &oAuthClients{client: c}
+	c.OAuthTokens = &oAuthTokens{client: c}
+	c.OrganizationMemberships = &organizationMemberships{client: c}
+	c.Organizations = &organizations{client: c}
+	c.OrganizationTags = &organizationTags{client: c}
+	c.OrganizationTokens = &organizationTokens{client: c}
+	c.PlanExports = &planExports{client: c}
+	c.Plans = &plans{client: c, cache: newPlanCache(c.planCacheSize)}
+	c.Policies = &policies{client: c}
+	c.PolicyChecks = &policyChecks{client: c}
+	c.PolicyEvaluations = &policyEvaluation{client: c}
+	c.PolicySetOutcomes = &policySetOutcome{client: c}
+	c.PolicySetParameters = &policySetParameters{client: c}
+	c.PolicySets = &policySets{client: c}
+	c.PolicySetVersions = &policySetVersions{client: c}
+	c.Projects = &projects{client: c}
+	c.RegistryModules = &registryModules{client: c}
+	c.RegistryProviderPlatforms = &registryProviderPlatforms{client: c}
+	c.RegistryProviders = &registryProviders{client: c}
+	c.RegistryProviderVersions = &registryProviderVersions{client: c}
+	c.Runs = &runs{client: c}
+	c.RunEvents = &runEvents{client: c}
+	c.RunTasks = &runTasks{client: c}
+	c.RunTriggers = &runTriggers{client: c}
+	c.SSHKeys = &sshKeys{client: c}
+	c.StateVersionOutputs = &stateVersionOutputs{client: c}
+	c.StateVersions = &stateVersions{client: c}
+	c.TaskResults = &taskResults{client: c}
+	c.TaskStages = &taskStages{client: c}
+	c.TeamAccess = &teamAccesses{client: c}
+	c.TeamMembers = &teamMembers{client: c}
+	c.TeamProjectAccess = &teamProjectAccesses{client: c}
+	c.Teams = &teams{client: c}
+	c.TeamTokens = &teamTokens{client: c}
+	c.TestRuns = &testRuns{client: c}
+	c.TestVariables = &testVariables{client: c}
+	c.Users = &users{client: c}
+	c.UserTokens = &userTokens{client: c}
+	c.Variables = &variables{client: c}
+	c.VariableSets = &variableSets{client: c}
+	c.VariableSetVariables = &variableSetVariables{client: c}
+	c.WorkspaceRunTasks = &workspaceRunTasks{client: c}
+	c.Workspaces = &workspaces{client: c}
+	c.WorkspaceResources = &workspaceResources{client: c}
+
+	c.Meta = Meta{
+		IPRanges: &ipRanges{client: c},
 	}
-
-	return client, nil
 }
 
 // AppName returns the name of the instance.
@@ -509,6 +695,41 @@ func (c Client) IsEnterprise() bool {
 	return !c.IsCloud()
 }
 
+// userAgentProduct returns the User-Agent product of an application, e.g.
+// "myapp/1.2.3". Both values must be HTTP tokens so they cannot be used to
+// inject other headers or break up the User-Agent header.
+func userAgentProduct(name, version string) (string, error) {
+	if name == "" {
+		return "", ErrRequiredAppName
+	}
+	if !validHTTPToken(name) {
+		return "", ErrInvalidAppName
+	}
+	if version == "" {
+		return name, nil
+	}
+	if !validHTTPToken(version) {
+		return "", ErrInvalidAppVersion
+	}
+	return name + "/" + version, nil
+}
+
+// validHTTPToken reports whether s is a token as defined by RFC 7230.
+func validHTTPToken(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case strings.ContainsRune("!#$%&'*+-.^_`|~", c):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 // RemoteAPIVersion returns the server's declared API version string.
 //
 // A Terraform Cloud or Enterprise API server returns its API version in an
@@ -574,12 +795,29 @@ func (c *Client) retryHTTPCheck(ctx context.Context, resp *http.Response, err er
 	if err != nil {
 		return c.retryServerErrors, err
 	}
+	if c.retryPolicy != nil && c.retryPolicy.IdempotentOnly && resp.Request != nil && !idempotentMethod(resp.Request.Method) {
+		return false, nil
+	}
 	if resp.StatusCode == 429 || (c.retryServerErrors && resp.StatusCode >= 500) {
 		return true, nil
 	}
+	if c.retryPolicy != nil && resp.StatusCode == 503 && resp.Header.Get("Retry-After") != "" {
+		return true, nil
+	}
 	return false, nil
 }
 
+// idempotentMethod reports whether sending a request with the given method
+// more than once has the same effect as sending it once.
+func idempotentMethod(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	default:
+		return false
+	}
+}
+
 // retryHTTPBackoff provides a generic callback for Client.Backoff which
 // will pass through all calls based on the status code of the response.
 func (c *Client) retryHTTPBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
@@ -587,6 +825,21 @@ func (c *Client) retryHTTPBackoff(min, max time.Duration, attemptNum int, resp *
 		c.retryLogHook(attemptNum, resp)
 	}
 
+	// Wait as long as the server asks for when a retry policy is set, up to
+	// the maximum wait of the policy.
+	if c.retryPolicy != nil && resp != nil && (resp.StatusCode == 429 || resp.StatusCode == 503) {
+		if delay, ok := retryAfter(resp.Header); ok {
+			maxWait := c.retryPolicy.MaxWait
+			if maxWait == 0 {
+				maxWait = defaultRetryMaxWait
+			}
+			if delay > maxWait {
+				delay = maxWait
+			}
+			return delay
+		}
+	}
+
 	// Use the rate limit backoff function when we are rate limited.
 	if resp != nil && resp.StatusCode == 429 {
 		return rateLimitBackoff(min, max, resp)
@@ -628,6 +881,32 @@ func rateLimitBackoff(min, max time.Duration, resp *http.Response) time.Duration
 	return min + jitter
 }
 
+// retryAfter parses the Retry-After header, which holds either a number of
+// seconds or an HTTP date, into the time to wait before retrying.
+func retryAfter(header http.Header) (time.Duration, bool) {
+	v := header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(v); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
 type rawAPIMetadata struct {
 	// APIVersion is the raw API version string reported by the server in the
 	// TFP-API-Version response header, or an empty string if that header
@@ -902,6 +1181,8 @@ func checkResponseCode(r *http.Response) error {
 	var err error
 
 	switch r.StatusCode {
+	case 304:
+		return ErrNotModified
 	case 400:
 		errs, err = decodeErrorPayload(r)
 		if err != nil {
@@ -966,16 +1247,72 @@ func decodeErrorPayload(r *http.Response) ([]string, error) {
 		return errs, errors.New(r.Status)
 	}
 
-	// Parse and format the errors.
-	for _, e := range errPayload.Errors {
+	return formatErrorObjects(errPayload.Errors), nil
+}
+
+// formatErrorObjects formats the title and detail of each error.
+func formatErrorObjects(objects []*jsonapi.ErrorObject) []string {
+	var errs []string
+	for _, e := range objects {
 		if e.Detail == "" {
 			errs = append(errs, e.Title)
 		} else {
 			errs = append(errs, fmt.Sprintf("%s\n\n%s", e.Title, e.Detail))
 		}
 	}
+	return errs
+}
+
+// ErrorsPayload is the error returned for an unsuccessful response that
+// included JSON:API errors. It wraps the error that the status of the response
+// maps to, so it can still be matched with errors.Is, for example against
+// ErrResourceNotFound.
+type ErrorsPayload struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Errors holds the JSON:API errors of the response, with their status,
+	// title and detail.
+	Errors []*jsonapi.ErrorObject
+
+	err error
+}
+
+func (e *ErrorsPayload) Error() string {
+	msg := e.err.Error()
+	details := strings.Join(formatErrorObjects(e.Errors), "\n")
+	if msg == details {
+		return msg
+	}
+	return fmt.Sprintf("%s: %s", msg, details)
+}
+
+func (e *ErrorsPayload) Unwrap() error {
+	return e.err
+}
+
+// checkResponseCodeWithErrorsPayload is similar to checkResponseCode except
+// that the error is wrapped in an *ErrorsPayload when the body of r holds
+// JSON:API errors.
+func checkResponseCodeWithErrorsPayload(r *http.Response) error {
+	if r.StatusCode >= 200 && r.StatusCode <= 299 {
+		return nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	err = checkResponseCode(r)
+
+	errPayload := &jsonapi.ErrorsPayload{}
+	if json.Unmarshal(body, errPayload) != nil || len(errPayload.Errors) == 0 {
+		return err
+	}
 
-	return errs, nil
+	return &ErrorsPayload{StatusCode: r.StatusCode, Errors: errPayload.Errors, err: err}
 }
 
 func errorPayloadContains(payloadErrors []string, match string) bool {
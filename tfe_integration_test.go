@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/hashicorp/jsonapi"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/time/rate"
 )
 
@@ -199,6 +201,74 @@ func TestClient_headers(t *testing.T) {
 	}
 }
 
+func TestClient_withBaseURL(t *testing.T) {
+	ctx := context.Background()
+
+	newServer := func(name, appName string) (*httptest.Server, *[]string) {
+		var paths []string
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer abcd1234", r.Header.Get("Authorization"))
+			assert.Equal(t, "foobar", r.Header.Get("My-Custom-Header"))
+
+			if r.URL.Path == "/api/v2/ping" {
+				w.Header().Set("TFP-AppName", appName)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			paths = append(paths, r.URL.Path)
+			w.Header().Set("Content-Type", ContentTypeJSONAPI)
+			fmt.Fprintf(w, `{"data":{"id":"plan-123","type":"plans","attributes":{"status":%q}}}`, name)
+		}))
+		return ts, &paths
+	}
+
+	tsA, pathsA := newServer("finished", "Terraform Enterprise")
+	defer tsA.Close()
+	tsB, pathsB := newServer("running", "Terraform Cloud")
+	defer tsB.Close()
+
+	headers := make(http.Header)
+	headers.Set("My-Custom-Header", "foobar")
+
+	client, err := NewClient(&Config{
+		Address:    tsA.URL,
+		Token:      "abcd1234",
+		Headers:    headers,
+		HTTPClient: tsA.Client(),
+	})
+	require.NoError(t, err)
+
+	clientB, err := client.WithBaseURL(tsB.URL)
+	require.NoError(t, err)
+
+	t.Run("requests are sent to the new instance", func(t *testing.T) {
+		p, err := clientB.Plans.Read(ctx, "plan-123")
+		require.NoError(t, err)
+		assert.Equal(t, PlanRunning, p.Status)
+		assert.Equal(t, []string{"/api/v2/plans/plan-123"}, *pathsB)
+		assert.True(t, clientB.IsCloud())
+	})
+
+	t.Run("the original client is left untouched", func(t *testing.T) {
+		p, err := client.Plans.Read(ctx, "plan-123")
+		require.NoError(t, err)
+		assert.Equal(t, PlanFinished, p.Status)
+		assert.Equal(t, []string{"/api/v2/plans/plan-123"}, *pathsA)
+		assert.False(t, client.IsCloud())
+	})
+
+	t.Run("the HTTP client is shared", func(t *testing.T) {
+		assert.Same(t, client.http, clientB.http)
+	})
+
+	t.Run("with an invalid address", func(t *testing.T) {
+		c, err := client.WithBaseURL("http://[::1")
+		assert.Nil(t, c)
+		assert.ErrorContains(t, err, "invalid address")
+	})
+}
+
 func TestClient_userAgent(t *testing.T) {
 	testedCalls := 0
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -246,6 +316,70 @@ func TestClient_userAgent(t *testing.T) {
 	}
 }
 
+func TestClient_appUserAgent(t *testing.T) {
+	var userAgents []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.Header.Get("User-Agent"))
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		w.WriteHeader(204)
+	}))
+	defer ts.Close()
+
+	t.Run("with an app name and version", func(t *testing.T) {
+		userAgents = nil
+
+		_, err := NewClient(&Config{
+			Address:    ts.URL,
+			Token:      "dummy-token",
+			HTTPClient: ts.Client(),
+			AppName:    "myapp",
+			AppVersion: "1.2.3",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"myapp/1.2.3 go-tfe"}, userAgents)
+	})
+
+	t.Run("with an app name and a custom user agent", func(t *testing.T) {
+		userAgents = nil
+
+		headers := make(http.Header)
+		headers.Set("User-Agent", "hashicorp")
+		_, err := NewClient(&Config{
+			Address:    ts.URL,
+			Token:      "dummy-token",
+			Headers:    headers,
+			HTTPClient: ts.Client(),
+			AppName:    "myapp",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"myapp hashicorp"}, userAgents)
+		assert.Equal(t, "hashicorp", headers.Get("User-Agent"))
+	})
+
+	t.Run("with invalid values", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			version string
+			err     error
+		}{
+			{"", "1.2.3", ErrRequiredAppName},
+			{"my app", "", ErrInvalidAppName},
+			{"myapp\r\nX-Injected: true", "", ErrInvalidAppName},
+			{"myapp", "1.2.3 (linux)", ErrInvalidAppVersion},
+			{"myapp", "1.2.3\n", ErrInvalidAppVersion},
+		} {
+			_, err := NewClient(&Config{
+				Address:    ts.URL,
+				Token:      "dummy-token",
+				HTTPClient: ts.Client(),
+				AppName:    tc.name,
+				AppVersion: tc.version,
+			})
+			assert.Equal(t, tc.err, err, "%q %q", tc.name, tc.version)
+		}
+	})
+}
+
 type JSONAPIBody struct {
 	StrAttr string `jsonapi:"attr,str_attr"`
 }
@@ -584,6 +718,161 @@ func TestClient_retryHTTPBackoff(t *testing.T) {
 	}
 }
 
+func TestClient_retryPolicy(t *testing.T) {
+	var requests map[string]int
+	var responses []int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		requests[r.Method]++
+		status := responses[0]
+		if len(responses) > 1 {
+			responses = responses[1:]
+		}
+		if status != http.StatusOK {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		fmt.Fprint(w, `{"data":{"id":"plan-123","type":"plans","attributes":{"status":"finished"}}}`)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(&Config{
+		Address:     ts.URL,
+		Token:       "dummy-token",
+		HTTPClient:  ts.Client(),
+		RetryPolicy: &RetryPolicy{MaxRetries: Int(2), IdempotentOnly: true},
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("when rate limited", func(t *testing.T) {
+		requests = map[string]int{}
+		responses = []int{http.StatusTooManyRequests, http.StatusOK}
+		p, err := client.Plans.Read(ctx, "plan-123")
+		require.NoError(t, err)
+		assert.Equal(t, PlanFinished, p.Status)
+		assert.Equal(t, 2, requests["GET"])
+	})
+
+	t.Run("when the server is unavailable", func(t *testing.T) {
+		requests = map[string]int{}
+		responses = []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK}
+		_, err := client.Plans.Read(ctx, "plan-123")
+		require.NoError(t, err)
+		assert.Equal(t, 3, requests["GET"])
+	})
+
+	t.Run("when the maximum number of retries is exceeded", func(t *testing.T) {
+		requests = map[string]int{}
+		responses = []int{http.StatusTooManyRequests}
+		_, err := client.Plans.Read(ctx, "plan-123")
+		assert.Error(t, err)
+		assert.Equal(t, 3, requests["GET"])
+	})
+
+	t.Run("with a request that is not idempotent", func(t *testing.T) {
+		requests = map[string]int{}
+		responses = []int{http.StatusTooManyRequests, http.StatusOK}
+		err := client.Runs.Apply(ctx, "run-123", RunApplyOptions{})
+		assert.Error(t, err)
+		assert.Equal(t, 1, requests["POST"])
+	})
+
+	t.Run("with a negative maximum number of retries", func(t *testing.T) {
+		_, err := NewClient(&Config{
+			Address:     ts.URL,
+			Token:       "dummy-token",
+			HTTPClient:  ts.Client(),
+			RetryPolicy: &RetryPolicy{MaxRetries: Int(-1)},
+		})
+		assert.EqualError(t, err, "invalid retry policy: max retries must not be negative")
+	})
+
+	t.Run("with retries disabled", func(t *testing.T) {
+		client, err := NewClient(&Config{
+			Address:     ts.URL,
+			Token:       "dummy-token",
+			HTTPClient:  ts.Client(),
+			RetryPolicy: &RetryPolicy{MaxRetries: Int(0)},
+		})
+		require.NoError(t, err)
+
+		requests = map[string]int{}
+		responses = []int{http.StatusTooManyRequests, http.StatusOK}
+		_, err = client.Plans.Read(ctx, "plan-123")
+		assert.Error(t, err)
+		assert.Equal(t, 1, requests["GET"])
+	})
+
+	t.Run("with a negative maximum wait", func(t *testing.T) {
+		_, err := NewClient(&Config{
+			Address:     ts.URL,
+			Token:       "dummy-token",
+			HTTPClient:  ts.Client(),
+			RetryPolicy: &RetryPolicy{MaxWait: -time.Second},
+		})
+		assert.EqualError(t, err, "invalid retry policy: max wait must not be negative")
+	})
+}
+
+func TestClient_retryPolicyMaxWait(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"3600"}},
+	}
+
+	t.Run("with the default maximum wait", func(t *testing.T) {
+		client := &Client{retryPolicy: &RetryPolicy{}}
+		assert.Equal(t, time.Minute, client.retryHTTPBackoff(time.Second, time.Second, 1, resp))
+	})
+
+	t.Run("with a custom maximum wait", func(t *testing.T) {
+		client := &Client{retryPolicy: &RetryPolicy{MaxWait: 5 * time.Second}}
+		assert.Equal(t, 5*time.Second, client.retryHTTPBackoff(time.Second, time.Second, 1, resp))
+	})
+
+	t.Run("when the server asks for less than the maximum wait", func(t *testing.T) {
+		client := &Client{retryPolicy: &RetryPolicy{MaxWait: 5 * time.Second}}
+		short := &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Header:     http.Header{"Retry-After": []string{"2"}},
+		}
+		assert.Equal(t, 2*time.Second, client.retryHTTPBackoff(time.Second, time.Second, 1, short))
+	})
+}
+
+func TestRetryAfter(t *testing.T) {
+	header := http.Header{}
+
+	_, ok := retryAfter(header)
+	assert.False(t, ok)
+
+	header.Set("Retry-After", "3")
+	delay, ok := retryAfter(header)
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, delay)
+
+	header.Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	delay, ok = retryAfter(header)
+	assert.True(t, ok)
+	assert.InDelta(t, time.Hour, delay, float64(2*time.Second))
+
+	header.Set("Retry-After", "Wed, 21 Oct 2015 07:28:00 GMT")
+	delay, ok = retryAfter(header)
+	assert.True(t, ok)
+	assert.Zero(t, delay)
+
+	header.Set("Retry-After", "soon")
+	_, ok = retryAfter(header)
+	assert.False(t, ok)
+}
+
 func setupEnvVars(token, address string) func() {
 	origToken := os.Getenv("TFE_TOKEN")
 	origAddress := os.Getenv("TFE_ADDRESS")
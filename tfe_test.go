@@ -6,7 +6,10 @@ package tfe
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
@@ -183,6 +186,59 @@ func Test_EncodeQueryParams(t *testing.T) {
 	})
 }
 
+func Test_NewRequestIncludes(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(204)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "foo",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	t.Run("with valid include paths", func(t *testing.T) {
+		req, err := client.NewRequest("GET", "workspaces/ws-1", &WorkspaceReadOptions{
+			Include: []WSIncludeOpt{WSCurrentRun, WSOrganization, WSCurrentRunConfigVer},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "current_run,organization,current_run.configuration_version", req.retryableRequest.URL.Query().Get("include"))
+	})
+
+	for name, include := range map[string]string{
+		"with an empty relation":      "current_run..plan",
+		"with a trailing dot":         "current_run.",
+		"with invalid characters":     "current_run&plan",
+		"with a too deep include":     "workspace.organization.owners.memberships",
+		"with an empty include value": "",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.NewRequest("GET", "workspaces/ws-1", &WorkspaceReadOptions{
+				Include: []WSIncludeOpt{WSIncludeOpt(include)},
+			})
+			assert.True(t, errors.Is(err, ErrInvalidIncludeValue))
+			assert.Contains(t, err.Error(), fmt.Sprintf("%q", include))
+		})
+	}
+
+	t.Run("with a configured maximum depth", func(t *testing.T) {
+		client, err := NewClient(&Config{
+			Address:         ts.URL,
+			Token:           "foo",
+			HTTPClient:      ts.Client(),
+			MaxIncludeDepth: 4,
+		})
+		require.NoError(t, err)
+
+		_, err = client.NewRequest("GET", "workspaces/ws-1", &WorkspaceReadOptions{
+			Include: []WSIncludeOpt{"workspace.organization.owners.memberships"},
+		})
+		require.NoError(t, err)
+	})
+}
+
 func Test_RegistryBasePath(t *testing.T) {
 	client, err := NewClient(&Config{
 		Token: "foo",
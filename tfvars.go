@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// parsedVariable is a variable read from a .tfvars or dotenv file.
+type parsedVariable struct {
+	Key   string
+	Value string
+	HCL   bool
+}
+
+// parseTfvars reads the attributes of a .tfvars file in the order they are
+// declared. Values that are a single quoted literal string are returned as
+// plain values, anything else (numbers, bools, lists, maps, templates,
+// heredocs) is returned as its HCL source so that it is evaluated the same
+// way Terraform would. Like in Terraform, values may not refer to variables
+// or call functions.
+func parseTfvars(src []byte) ([]*parsedVariable, error) {
+	f, diags := hclsyntax.ParseConfig(src, "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, tfvarsError(diags)
+	}
+
+	attrs, diags := f.Body.JustAttributes()
+	if diags.HasErrors() {
+		return nil, tfvarsError(diags)
+	}
+
+	sorted := make([]*hcl.Attribute, 0, len(attrs))
+	for _, attr := range attrs {
+		sorted = append(sorted, attr)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Range.Start.Byte < sorted[j].Range.Start.Byte
+	})
+
+	vars := make([]*parsedVariable, 0, len(sorted))
+	for _, attr := range sorted {
+		val, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() {
+			return nil, tfvarsError(diags)
+		}
+
+		raw := attr.Expr.Range().SliceBytes(src)
+		v := &parsedVariable{Key: attr.Name, Value: string(raw), HCL: true}
+
+		// Heredocs are template expressions too, but are kept as HCL.
+		if t, ok := attr.Expr.(*hclsyntax.TemplateExpr); ok && t.IsStringLiteral() && bytes.HasPrefix(raw, []byte(`"`)) {
+			v.Value = val.AsString()
+			v.HCL = false
+		}
+		vars = append(vars, v)
+	}
+
+	return vars, nil
+}
+
+// tfvarsError returns the first error of diags along with its line.
+func tfvarsError(diags hcl.Diagnostics) error {
+	for _, d := range diags {
+		if d.Severity != hcl.DiagError {
+			continue
+		}
+
+		msg := d.Summary
+		if d.Detail != "" {
+			msg = fmt.Sprintf("%s: %s", msg, d.Detail)
+		}
+		if d.Subject == nil {
+			return errors.New(msg)
+		}
+		return fmt.Errorf("line %d: %s", d.Subject.Start.Line, msg)
+	}
+	return diags
+}
+
+// validHCLValue reports whether value is a single HCL expression, which is
+// what the API accepts as the value of an HCL variable.
+func validHCLValue(value string) bool {
+	_, diags := hclsyntax.ParseExpression([]byte(value), "", hcl.InitialPos)
+	return !diags.HasErrors()
+}
+
+// parseDotenv reads the KEY=VALUE lines of a dotenv file. Lines may start
+// with "export", values may be single or double quoted, and unquoted values
+// end at a " #" comment.
+func parseDotenv(src []byte) ([]*parsedVariable, error) {
+	var vars []*parsedVariable
+
+	scanner := bufio.NewScanner(bytes.NewReader(src))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		text = strings.TrimSpace(strings.TrimPrefix(text, "export "))
+
+		key, value, ok := strings.Cut(text, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" || strings.ContainsAny(key, " \t") {
+			return nil, fmt.Errorf("line %d: expected KEY=VALUE", line)
+		}
+		value = strings.TrimSpace(value)
+
+		switch {
+		case strings.HasPrefix(value, `"`):
+			end := strings.LastIndex(value, `"`)
+			if end == 0 {
+				return nil, fmt.Errorf("line %d: unterminated quoted value for %q", line, key)
+			}
+			value = strings.NewReplacer(`\n`, "\n", `\"`, `"`, `\\`, `\`).Replace(value[1:end])
+		case strings.HasPrefix(value, "'"):
+			end := strings.LastIndex(value, "'")
+			if end == 0 {
+				return nil, fmt.Errorf("line %d: unterminated quoted value for %q", line, key)
+			}
+			value = value[1:end]
+		default:
+			if i := strings.Index(value, " #"); i >= 0 {
+				value = strings.TrimSpace(value[:i])
+			}
+		}
+
+		vars = append(vars, &parsedVariable{Key: key, Value: value})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return vars, nil
+}
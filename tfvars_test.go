@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTfvars(t *testing.T) {
+	src := `# Region settings
+region = "us-east-1" # primary
+name   = "web \"server\"\n"
+count  = 3
+enabled = true
+
+tags = {
+  Name = "web" // the name
+  Env  = "prod"
+}
+
+zones = [
+  "a",
+  "b",
+]
+
+greeting = "hello ${"world"}"
+escaped  = "$${literal}"
+
+/* a block
+   comment */
+policy = <<-EOT
+  {
+    "Version": "2012-10-17"
+  }
+  EOT
+`
+
+	vars, err := parseTfvars([]byte(src))
+	require.NoError(t, err)
+
+	assert.Equal(t, []*parsedVariable{
+		{Key: "region", Value: "us-east-1"},
+		{Key: "name", Value: "web \"server\"\n"},
+		{Key: "count", Value: "3", HCL: true},
+		{Key: "enabled", Value: "true", HCL: true},
+		{Key: "tags", Value: "{\n  Name = \"web\" // the name\n  Env  = \"prod\"\n}", HCL: true},
+		{Key: "zones", Value: "[\n  \"a\",\n  \"b\",\n]", HCL: true},
+		{Key: "greeting", Value: `"hello ${"world"}"`, HCL: true},
+		{Key: "escaped", Value: "${literal}"},
+		{Key: "policy", Value: "<<-EOT\n  {\n    \"Version\": \"2012-10-17\"\n  }\n  EOT", HCL: true},
+	}, vars)
+
+	t.Run("with invalid files", func(t *testing.T) {
+		for name, src := range map[string]string{
+			"missing equals":      `region "us-east-1"`,
+			"missing value":       `region =`,
+			"missing name":        `= "us-east-1"`,
+			"unterminated string": `region = "us-east-1`,
+			"unclosed bracket":    "zones = [\n\"a\"",
+			"unterminated doc":    "policy = <<EOT\n{}\n",
+			"two assignments":     `count = 1 enabled = true`,
+			"duplicate name":      "count = 1\ncount = 2",
+			"a block":             `tags { Name = "web" }`,
+			"a reference":         `region = var.region`,
+			"a bare word":         `region = us-east-1`,
+			"a function call":     `region = lower("US-EAST-1")`,
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := parseTfvars([]byte(src))
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestParseDotenv(t *testing.T) {
+	src := `# credentials
+AWS_REGION=us-east-1
+export TF_LOG = debug # verbose
+GREETING="hello\nworld"
+RAW='single $quoted'
+EMPTY=
+`
+
+	vars, err := parseDotenv([]byte(src))
+	require.NoError(t, err)
+
+	assert.Equal(t, []*parsedVariable{
+		{Key: "AWS_REGION", Value: "us-east-1"},
+		{Key: "TF_LOG", Value: "debug"},
+		{Key: "GREETING", Value: "hello\nworld"},
+		{Key: "RAW", Value: "single $quoted"},
+		{Key: "EMPTY", Value: ""},
+	}, vars)
+
+	t.Run("with invalid files", func(t *testing.T) {
+		for name, src := range map[string]string{
+			"missing equals":      "AWS_REGION",
+			"missing key":         "=us-east-1",
+			"unterminated quotes": `GREETING="hello`,
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := parseDotenv([]byte(src))
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestValidHCLValue(t *testing.T) {
+	for _, value := range []string{
+		`"us-east-1"`,
+		`3`,
+		`-1.5`,
+		`true`,
+		`null`,
+		`["a", "b"]`,
+		"{\n  region = \"us-east-1\" # primary\n}",
+		"<<EOT\nhello\nEOT\n",
+		`newvalue`,
+		`var.region`,
+		`upper("us-east-1")`,
+	} {
+		assert.True(t, validHCLValue(value), value)
+	}
+
+	for _, value := range []string{
+		``,
+		`hello world`,
+		`"unterminated`,
+		`["a"`,
+		`"a"]`,
+		"\"a\"\n\"b\"",
+		`[1}`,
+		`1 2`,
+		`"a" "b"`,
+		`-`,
+		`[1, 2] foo`,
+		`null null`,
+	} {
+		assert.False(t, validHCLValue(value), value)
+	}
+}
@@ -124,6 +124,11 @@ func String(v string) *string {
 	return &v
 }
 
+// TaskEnforcement returns a pointer to the given task enforcement level.
+func TaskEnforcement(v TaskEnforcementLevel) *TaskEnforcementLevel {
+	return &v
+}
+
 func NullableBool(v bool) jsonapi.NullableAttr[bool] {
 	return jsonapi.NewNullableAttrWithValue[bool](v)
 }
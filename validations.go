@@ -6,6 +6,7 @@ package tfe
 import (
 	"net/mail"
 	"regexp"
+	"strings"
 
 	version "github.com/hashicorp/go-version"
 )
@@ -13,12 +14,30 @@ import (
 // A regular expression used to validate common string ID patterns.
 var reStringID = regexp.MustCompile(`^[a-zA-Z0-9\-._]+$`)
 
+// A regular expression used to validate the relation names of an include path.
+var reIncludeRelation = regexp.MustCompile(`^[a-z][a-z0-9_\-]*$`)
+
 // validEmail checks if the given input is a correct email
 func validEmail(v string) bool {
 	_, err := mail.ParseAddress(v)
 	return err == nil
 }
 
+// validIncludePath checks if the given include path is a dot-separated list
+// of relation names that is at most maxDepth relations deep.
+func validIncludePath(v string, maxDepth int) bool {
+	relations := strings.Split(v, ".")
+	if len(relations) > maxDepth {
+		return false
+	}
+	for _, r := range relations {
+		if !reIncludeRelation.MatchString(r) {
+			return false
+		}
+	}
+	return true
+}
+
 // validString checks if the given input is present and non-empty.
 func validString(v *string) bool {
 	return v != nil && *v != ""
@@ -6,6 +6,7 @@ package tfe
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 )
 
@@ -31,6 +32,13 @@ type Variables interface {
 
 	// Delete a variable by its ID.
 	Delete(ctx context.Context, workspaceID string, variableID string) error
+
+	// DeleteByKey deletes the variable with the given key and category.
+	DeleteByKey(ctx context.Context, workspaceID, key string, category CategoryType) error
+
+	// ApplyTfvars creates or updates a variable for every assignment in a
+	// .tfvars file, or a dotenv file for environment variables.
+	ApplyTfvars(ctx context.Context, workspaceID string, r io.Reader, category CategoryType, options ApplyTfvarsOptions) ([]*Variable, error)
 }
 
 // variables implements Variables.
@@ -74,6 +82,13 @@ type VariableListOptions struct {
 	ListOptions
 }
 
+// ApplyTfvarsOptions represents the options for applying a variables file to
+// a workspace.
+type ApplyTfvarsOptions struct {
+	// Optional: Mark every variable in the file as sensitive.
+	Sensitive bool
+}
+
 // VariableCreateOptions represents the options for creating a new variable.
 type VariableCreateOptions struct {
 	// Type is a public field utilized by JSON:API to
@@ -205,6 +220,9 @@ func (s *variables) Update(ctx context.Context, workspaceID, variableID string,
 	if !validStringID(&variableID) {
 		return nil, ErrInvalidVariableID
 	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
 
 	u := fmt.Sprintf("workspaces/%s/vars/%s", url.QueryEscape(workspaceID), url.QueryEscape(variableID))
 	req, err := s.client.NewRequest("PATCH", u, &options)
@@ -239,6 +257,121 @@ func (s *variables) Delete(ctx context.Context, workspaceID, variableID string)
 	return req.Do(ctx, nil)
 }
 
+// DeleteByKey deletes the variable with the given key and category. It
+// returns ErrResourceNotFound when the workspace has no such variable.
+func (s *variables) DeleteByKey(ctx context.Context, workspaceID, key string, category CategoryType) error {
+	if !validStringID(&workspaceID) {
+		return ErrInvalidWorkspaceID
+	}
+	if !validString(&key) {
+		return ErrRequiredKey
+	}
+	if category == "" {
+		return ErrRequiredCategory
+	}
+
+	options := &VariableListOptions{}
+	for {
+		vl, err := s.List(ctx, workspaceID, options)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range vl.Items {
+			if v.Key == key && v.Category == category {
+				return s.Delete(ctx, workspaceID, v.ID)
+			}
+		}
+
+		if vl.Pagination == nil || vl.NextPage == 0 {
+			break
+		}
+		options.PageNumber = vl.NextPage
+	}
+
+	return ErrResourceNotFound
+}
+
+// ApplyTfvars creates or updates a variable for every assignment in a
+// .tfvars file when category is CategoryTerraform, or in a dotenv file when
+// it is CategoryEnv. Literal strings are stored as plain values and any other
+// value, such as a list or map, is stored as HCL. Existing variables with the
+// same key and category are updated, other variables are left untouched.
+func (s *variables) ApplyTfvars(ctx context.Context, workspaceID string, r io.Reader, category CategoryType, options ApplyTfvarsOptions) ([]*Variable, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed []*parsedVariable
+	switch category {
+	case CategoryTerraform:
+		parsed, err = parseTfvars(src)
+	case CategoryEnv:
+		parsed, err = parseDotenv(src)
+	default:
+		return nil, ErrInvalidTfvarsCategory
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]*Variable)
+	listOptions := &VariableListOptions{}
+	for {
+		vl, err := s.List(ctx, workspaceID, listOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range vl.Items {
+			if v.Category == category {
+				existing[v.Key] = v
+			}
+		}
+
+		if vl.Pagination == nil || vl.NextPage == 0 {
+			break
+		}
+		listOptions.PageNumber = vl.NextPage
+	}
+
+	var sensitive *bool
+	if options.Sensitive {
+		sensitive = Bool(true)
+	}
+
+	result := make([]*Variable, 0, len(parsed))
+	for _, pv := range parsed {
+		var v *Variable
+		if current, ok := existing[pv.Key]; ok {
+			v, err = s.Update(ctx, workspaceID, current.ID, VariableUpdateOptions{
+				Value:     String(pv.Value),
+				HCL:       Bool(pv.HCL),
+				Sensitive: sensitive,
+			})
+		} else {
+			v, err = s.Create(ctx, workspaceID, VariableCreateOptions{
+				Key:       String(pv.Key),
+				Value:     String(pv.Value),
+				Category:  Category(category),
+				HCL:       Bool(pv.HCL),
+				Sensitive: sensitive,
+			})
+		}
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+
+	return result, nil
+}
+
 func (o VariableCreateOptions) valid() error {
 	if !validString(o.Key) {
 		return ErrRequiredKey
@@ -246,5 +379,15 @@ func (o VariableCreateOptions) valid() error {
 	if o.Category == nil {
 		return ErrRequiredCategory
 	}
+	if o.HCL != nil && *o.HCL && o.Value != nil && !validHCLValue(*o.Value) {
+		return ErrInvalidHCLValue
+	}
+	return nil
+}
+
+func (o VariableUpdateOptions) valid() error {
+	if o.HCL != nil && *o.HCL && o.Value != nil && !validHCLValue(*o.Value) {
+		return ErrInvalidHCLValue
+	}
 	return nil
 }
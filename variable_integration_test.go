@@ -5,6 +5,7 @@ package tfe
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -89,6 +90,34 @@ func TestVariablesCreate(t *testing.T) {
 		assert.NotEmpty(t, v.VersionID)
 	})
 
+	t.Run("with an HCL value", func(t *testing.T) {
+		options := VariableCreateOptions{
+			Key:      String(randomString(t)),
+			Value:    String(`{ region = "us-east-1" }`),
+			Category: Category(CategoryTerraform),
+			HCL:      Bool(true),
+		}
+
+		v, err := client.Variables.Create(ctx, wTest.ID, options)
+		require.NoError(t, err)
+
+		assert.Equal(t, *options.Value, v.Value)
+		assert.True(t, v.HCL)
+	})
+
+	t.Run("with an invalid HCL value", func(t *testing.T) {
+		options := VariableCreateOptions{
+			Key:      String(randomString(t)),
+			Value:    String(`"us-east-1`),
+			Category: Category(CategoryTerraform),
+			HCL:      Bool(true),
+		}
+
+		v, err := client.Variables.Create(ctx, wTest.ID, options)
+		assert.Nil(t, v)
+		assert.Equal(t, ErrInvalidHCLValue, err)
+	})
+
 	t.Run("when options has an empty string value", func(t *testing.T) {
 		options := VariableCreateOptions{
 			Key:         String(randomString(t)),
@@ -259,6 +288,31 @@ func TestVariablesUpdate(t *testing.T) {
 		assert.NotEqual(t, vTest.VersionID, v.VersionID)
 	})
 
+	t.Run("with a description", func(t *testing.T) {
+		options := VariableUpdateOptions{
+			Description: String("an updated description"),
+		}
+
+		v, err := client.Variables.Update(ctx, vTest.Workspace.ID, vTest.ID, options)
+		require.NoError(t, err)
+		assert.Equal(t, *options.Description, v.Description)
+
+		v, err = client.Variables.Read(ctx, vTest.Workspace.ID, vTest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, *options.Description, v.Description)
+	})
+
+	t.Run("with an invalid HCL value", func(t *testing.T) {
+		options := VariableUpdateOptions{
+			Value: String(`"newvalue`),
+			HCL:   Bool(true),
+		}
+
+		v, err := client.Variables.Update(ctx, vTest.Workspace.ID, vTest.ID, options)
+		assert.Nil(t, v)
+		assert.Equal(t, ErrInvalidHCLValue, err)
+	})
+
 	t.Run("when updating a subset of values", func(t *testing.T) {
 		options := VariableUpdateOptions{
 			Key: String("someothername"),
@@ -356,3 +410,105 @@ func TestVariablesDelete(t *testing.T) {
 		assert.Equal(t, err, ErrInvalidVariableID)
 	})
 }
+
+func TestVariablesDeleteByKey(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	defer wTestCleanup()
+
+	vTest, _ := createVariable(t, client, wTest)
+
+	t.Run("with a different category", func(t *testing.T) {
+		err := client.Variables.DeleteByKey(ctx, wTest.ID, vTest.Key, CategoryEnv)
+		assert.Equal(t, err, ErrResourceNotFound)
+	})
+
+	t.Run("with valid options", func(t *testing.T) {
+		err := client.Variables.DeleteByKey(ctx, wTest.ID, vTest.Key, vTest.Category)
+		require.NoError(t, err)
+
+		_, err = client.Variables.Read(ctx, wTest.ID, vTest.ID)
+		assert.Equal(t, err, ErrResourceNotFound)
+	})
+
+	t.Run("with non existing key", func(t *testing.T) {
+		err := client.Variables.DeleteByKey(ctx, wTest.ID, "nonexisting", CategoryTerraform)
+		assert.Equal(t, err, ErrResourceNotFound)
+	})
+
+	t.Run("with invalid workspace ID", func(t *testing.T) {
+		err := client.Variables.DeleteByKey(ctx, badIdentifier, vTest.Key, vTest.Category)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+
+	t.Run("without a key", func(t *testing.T) {
+		err := client.Variables.DeleteByKey(ctx, wTest.ID, "", vTest.Category)
+		assert.Equal(t, err, ErrRequiredKey)
+	})
+
+	t.Run("without a category", func(t *testing.T) {
+		err := client.Variables.DeleteByKey(ctx, wTest.ID, vTest.Key, "")
+		assert.Equal(t, err, ErrRequiredCategory)
+	})
+}
+
+func TestVariablesApplyTfvars(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	defer wTestCleanup()
+
+	vTest, _ := createVariable(t, client, wTest)
+
+	t.Run("with a tfvars file", func(t *testing.T) {
+		tfvars := vTest.Key + " = \"updated\"\nzones = [\"a\", \"b\"]\n"
+
+		vl, err := client.Variables.ApplyTfvars(ctx, wTest.ID, strings.NewReader(tfvars), CategoryTerraform, ApplyTfvarsOptions{})
+		require.NoError(t, err)
+		require.Len(t, vl, 2)
+
+		assert.Equal(t, vTest.ID, vl[0].ID)
+		assert.Equal(t, "updated", vl[0].Value)
+		assert.False(t, vl[0].HCL)
+
+		assert.Equal(t, "zones", vl[1].Key)
+		assert.Equal(t, `["a", "b"]`, vl[1].Value)
+		assert.True(t, vl[1].HCL)
+		assert.Equal(t, CategoryTerraform, vl[1].Category)
+	})
+
+	t.Run("with a dotenv file marked as sensitive", func(t *testing.T) {
+		dotenv := "AWS_REGION=us-east-1\n"
+
+		vl, err := client.Variables.ApplyTfvars(ctx, wTest.ID, strings.NewReader(dotenv), CategoryEnv, ApplyTfvarsOptions{
+			Sensitive: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, vl, 1)
+
+		assert.Equal(t, "AWS_REGION", vl[0].Key)
+		assert.Equal(t, CategoryEnv, vl[0].Category)
+		assert.True(t, vl[0].Sensitive)
+	})
+
+	t.Run("with an invalid file", func(t *testing.T) {
+		vl, err := client.Variables.ApplyTfvars(ctx, wTest.ID, strings.NewReader("zones = ["), CategoryTerraform, ApplyTfvarsOptions{})
+		assert.Nil(t, vl)
+		assert.Error(t, err)
+	})
+
+	t.Run("with an unsupported category", func(t *testing.T) {
+		vl, err := client.Variables.ApplyTfvars(ctx, wTest.ID, strings.NewReader(""), CategoryPolicySet, ApplyTfvarsOptions{})
+		assert.Nil(t, vl)
+		assert.Equal(t, err, ErrInvalidTfvarsCategory)
+	})
+
+	t.Run("with invalid workspace ID", func(t *testing.T) {
+		vl, err := client.Variables.ApplyTfvars(ctx, badIdentifier, strings.NewReader(""), CategoryTerraform, ApplyTfvarsOptions{})
+		assert.Nil(t, vl)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
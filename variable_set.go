@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 )
 
 // Compile-time proof of interface implementation.
@@ -73,6 +74,12 @@ type VariableSet struct {
 	Global      bool   `jsonapi:"attr,global"`
 	Priority    bool   `jsonapi:"attr,priority"`
 
+	// The number of workspaces and projects the variable set is applied to,
+	// and the number of variables it contains.
+	WorkspaceCount int `jsonapi:"attr,workspace-count"`
+	ProjectCount   int `jsonapi:"attr,project-count"`
+	VarCount       int `jsonapi:"attr,var-count"`
+
 	// Relations
 	Organization *Organization          `jsonapi:"relation,organization"`
 	Workspaces   []*Workspace           `jsonapi:"relation,workspaces,omitempty"`
@@ -93,6 +100,8 @@ const (
 // VariableSetListOptions represents the options for listing variable sets.
 type VariableSetListOptions struct {
 	ListOptions
+	// Optional: A comma-separated list of relations to include, see
+	// VariableSetIncludeOpt for the available values.
 	Include string `url:"include"`
 }
 
@@ -455,6 +464,16 @@ func (s *variableSets) UpdateWorkspaces(ctx context.Context, variableSetID strin
 }
 
 func (o *VariableSetListOptions) valid() error {
+	if o.Include == "" {
+		return nil
+	}
+	for _, include := range strings.Split(o.Include, ",") {
+		switch VariableSetIncludeOpt(strings.TrimSpace(include)) {
+		case VariableSetWorkspaces, VariableSetProjects, VariableSetVars:
+		default:
+			return ErrInvalidIncludeValue
+		}
+	}
 	return nil
 }
 
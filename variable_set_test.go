@@ -5,6 +5,9 @@ package tfe
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -59,6 +62,69 @@ func TestVariableSetsList(t *testing.T) {
 	})
 }
 
+func TestVariableSetsList_PriorityAndCounts(t *testing.T) {
+	ctx := context.Background()
+
+	var query string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		query = r.URL.Query().Get("include")
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		fmt.Fprint(w, `{
+  "data": [{
+    "id": "varset-123",
+    "type": "varsets",
+    "attributes": {
+      "name": "shared",
+      "global": false,
+      "priority": true,
+      "workspace-count": 2,
+      "project-count": 1,
+      "var-count": 3
+    },
+    "relationships": {
+      "workspaces": {"data": [{"id": "ws-1", "type": "workspaces"}, {"id": "ws-2", "type": "workspaces"}]},
+      "projects": {"data": [{"id": "prj-1", "type": "projects"}]}
+    }
+  }],
+  "meta": {"pagination": {"current-page": 1, "total-pages": 1, "total-count": 1}}
+}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+
+	t.Run("with includes", func(t *testing.T) {
+		vsl, err := client.VariableSets.List(ctx, "my-org", &VariableSetListOptions{
+			Include: fmt.Sprintf("%s,%s", VariableSetWorkspaces, VariableSetProjects),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "workspaces,projects", query)
+
+		require.Len(t, vsl.Items, 1)
+		vs := vsl.Items[0]
+		assert.True(t, vs.Priority)
+		assert.Equal(t, 2, vs.WorkspaceCount)
+		assert.Equal(t, 1, vs.ProjectCount)
+		assert.Equal(t, 3, vs.VarCount)
+		assert.Len(t, vs.Workspaces, 2)
+		assert.Len(t, vs.Projects, 1)
+	})
+
+	t.Run("with an invalid include", func(t *testing.T) {
+		vsl, err := client.VariableSets.List(ctx, "my-org", &VariableSetListOptions{
+			Include: "workspaces,organization",
+		})
+		assert.Nil(t, vsl)
+		assert.Equal(t, ErrInvalidIncludeValue, err)
+	})
+}
+
 func TestVariableSetsListForWorkspace(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
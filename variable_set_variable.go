@@ -31,6 +31,10 @@ type VariableSetVariables interface {
 
 	// Delete a variable by its ID
 	Delete(ctx context.Context, variableSetID string, variableID string) error
+
+	// BatchUpsert creates or updates several variables of a variable set
+	// concurrently, matching existing variables by key and category.
+	BatchUpsert(ctx context.Context, variableSetID string, options []*VariableSetVariableCreateOptions) ([]*VariableSetVariable, error)
 }
 
 type variableSetVariables struct {
@@ -124,6 +128,9 @@ func (o VariableSetVariableCreateOptions) valid() error {
 	if o.Category == nil {
 		return ErrRequiredCategory
 	}
+	if o.HCL != nil && *o.HCL && o.Value != nil && !validHCLValue(*o.Value) {
+		return ErrInvalidHCLValue
+	}
 	return nil
 }
 
@@ -202,6 +209,13 @@ type VariableSetVariableUpdateOptions struct {
 	Sensitive *bool `jsonapi:"attr,sensitive,omitempty"`
 }
 
+func (o VariableSetVariableUpdateOptions) valid() error {
+	if o.HCL != nil && *o.HCL && o.Value != nil && !validHCLValue(*o.Value) {
+		return ErrInvalidHCLValue
+	}
+	return nil
+}
+
 // Update values of an existing variable.
 func (s *variableSetVariables) Update(ctx context.Context, variableSetID, variableID string, options *VariableSetVariableUpdateOptions) (*VariableSetVariable, error) {
 	if !validStringID(&variableSetID) {
@@ -210,6 +224,11 @@ func (s *variableSetVariables) Update(ctx context.Context, variableSetID, variab
 	if !validStringID(&variableID) {
 		return nil, ErrInvalidVariableID
 	}
+	if options != nil {
+		if err := options.valid(); err != nil {
+			return nil, err
+		}
+	}
 
 	u := fmt.Sprintf("varsets/%s/relationships/vars/%s", url.QueryEscape(variableSetID), url.QueryEscape(variableID))
 	req, err := s.client.NewRequest("PATCH", u, options)
@@ -243,3 +262,80 @@ func (s *variableSetVariables) Delete(ctx context.Context, variableSetID, variab
 
 	return req.Do(ctx, nil)
 }
+
+// BatchUpsert creates or updates the given variables of a variable set,
+// several at a time. Variables with the same key and category as an existing
+// variable update it, other variables are created. It returns the variables
+// that were created or updated at the index of their options, with nil for the
+// variables that failed. All options are validated before any request is
+// made. When some variables fail, the others are still created or updated and
+// a *BatchError keyed by the category and key of each variable, such as
+// "env/region", is returned along with them.
+func (s *variableSetVariables) BatchUpsert(ctx context.Context, variableSetID string, options []*VariableSetVariableCreateOptions) ([]*VariableSetVariable, error) {
+	if !validStringID(&variableSetID) {
+		return nil, ErrInvalidVariableSetID
+	}
+
+	seen := make(map[string]bool, len(options))
+	for _, o := range options {
+		if o == nil {
+			return nil, ErrRequiredKey
+		}
+		if err := o.valid(); err != nil {
+			return nil, err
+		}
+		id := variableSetVariableKey(*o.Key, *o.Category)
+		if seen[id] {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateVariableKey, *o.Key)
+		}
+		seen[id] = true
+	}
+
+	existing := make(map[string]*VariableSetVariable)
+	listOptions := &VariableSetVariableListOptions{}
+	for {
+		vl, err := s.List(ctx, variableSetID, listOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range vl.Items {
+			existing[variableSetVariableKey(v.Key, v.Category)] = v
+		}
+
+		if vl.Pagination == nil || vl.NextPage == 0 {
+			break
+		}
+		listOptions.PageNumber = vl.NextPage
+	}
+
+	keys := make([]string, len(options))
+	for i, o := range options {
+		keys[i] = variableSetVariableKey(*o.Key, *o.Category)
+	}
+
+	upserted := make([]*VariableSetVariable, len(options))
+	err := doBatch(keys, "upsert", "variable", func(i int) error {
+		var err error
+		o := options[i]
+		if current, ok := existing[variableSetVariableKey(*o.Key, *o.Category)]; ok {
+			upserted[i], err = s.Update(ctx, variableSetID, current.ID, &VariableSetVariableUpdateOptions{
+				Key:         o.Key,
+				Value:       o.Value,
+				Description: o.Description,
+				HCL:         o.HCL,
+				Sensitive:   o.Sensitive,
+			})
+		} else {
+			upserted[i], err = s.Create(ctx, variableSetID, o)
+		}
+		return err
+	})
+
+	return upserted, err
+}
+
+// variableSetVariableKey identifies a variable within a variable set.
+func variableSetVariableKey(key string, category CategoryType) string {
+	return string(category) + "/" + key
+}
@@ -5,6 +5,13 @@ package tfe
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -102,6 +109,19 @@ func TestVariableSetVariablesCreate(t *testing.T) {
 		assert.NotEmpty(t, v.VersionID)
 	})
 
+	t.Run("with an invalid HCL value", func(t *testing.T) {
+		options := VariableSetVariableCreateOptions{
+			Key:      String(randomString(t)),
+			Value:    String("[\"a\""),
+			Category: Category(CategoryTerraform),
+			HCL:      Bool(true),
+		}
+
+		v, err := client.VariableSetVariables.Create(ctx, vsTest.ID, &options)
+		assert.Nil(t, v)
+		assert.Equal(t, ErrInvalidHCLValue, err)
+	})
+
 	t.Run("when options has an empty string value", func(t *testing.T) {
 		options := VariableSetVariableCreateOptions{
 			Key:         String(randomString(t)),
@@ -281,6 +301,17 @@ func TestVariableSetVariablesUpdate(t *testing.T) {
 		assert.NotEqual(t, vTest.VersionID, v.VersionID)
 	})
 
+	t.Run("with an invalid HCL value", func(t *testing.T) {
+		options := VariableSetVariableUpdateOptions{
+			Value: String(`"newvalue`),
+			HCL:   Bool(true),
+		}
+
+		v, err := client.VariableSetVariables.Update(ctx, vsTest.ID, vTest.ID, &options)
+		assert.Nil(t, v)
+		assert.Equal(t, ErrInvalidHCLValue, err)
+	})
+
 	t.Run("when updating a subset of values", func(t *testing.T) {
 		options := VariableSetVariableUpdateOptions{
 			Key: String("someothername"),
@@ -373,3 +404,120 @@ func TestVariableSetVariablesDelete(t *testing.T) {
 		assert.EqualError(t, err, ErrInvalidVariableID.Error())
 	})
 }
+
+func TestVariableSetVariablesBatchUpsert(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"data":[
+  {"id":"var-1","type":"vars","attributes":{"key":"region","value":"us-east-1","category":"terraform"}},
+  {"id":"var-2","type":"vars","attributes":{"key":"region","value":"eu-west-1","category":"env"}}
+]}`)
+		case "PATCH":
+			body, _ := io.ReadAll(r.Body)
+			fmt.Fprintf(w, `{"data":{"id":%q,"type":"vars","attributes":{"key":"region","value":"us-west-2","category":"terraform"}}}`, r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+			assert.Contains(t, string(body), `"us-west-2"`)
+		case "POST":
+			body, _ := io.ReadAll(r.Body)
+			if strings.Contains(string(body), `"broken"`) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				fmt.Fprint(w, `{"errors":[{"status":"422","title":"invalid attribute","detail":"Key has already been taken"}]}`)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data":{"id":"var-3","type":"vars","attributes":{"key":"zones","value":"[\"a\"]","category":"terraform","hcl":true}}}`)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+
+	t.Run("with new and existing variables", func(t *testing.T) {
+		requests = nil
+		vs, err := client.VariableSetVariables.BatchUpsert(ctx, "varset-123", []*VariableSetVariableCreateOptions{
+			{Key: String("region"), Value: String("us-west-2"), Category: Category(CategoryTerraform)},
+			{Key: String("zones"), Value: String(`["a"]`), Category: Category(CategoryTerraform), HCL: Bool(true)},
+		})
+		require.NoError(t, err)
+		require.Len(t, vs, 2)
+		assert.Equal(t, "var-1", vs[0].ID)
+		assert.Equal(t, "var-3", vs[1].ID)
+		assert.ElementsMatch(t, []string{
+			"GET /api/v2/varsets/varset-123/relationships/vars",
+			"PATCH /api/v2/varsets/varset-123/relationships/vars/var-1",
+			"POST /api/v2/varsets/varset-123/relationships/vars",
+		}, requests)
+	})
+
+	t.Run("when some variables fail", func(t *testing.T) {
+		vs, err := client.VariableSetVariables.BatchUpsert(ctx, "varset-123", []*VariableSetVariableCreateOptions{
+			{Key: String("zones"), Value: String(`["a"]`), Category: Category(CategoryTerraform), HCL: Bool(true)},
+			{Key: String("broken"), Value: String("x"), Category: Category(CategoryTerraform)},
+		})
+		require.Len(t, vs, 2)
+		assert.Equal(t, "var-3", vs[0].ID)
+		assert.Nil(t, vs[1])
+
+		var upsertErr *BatchError
+		require.True(t, errors.As(err, &upsertErr))
+		assert.Len(t, upsertErr.Errors, 1)
+		assert.Contains(t, upsertErr.Errors, "terraform/broken")
+	})
+
+	t.Run("when variables with the same key in different categories fail", func(t *testing.T) {
+		vs, err := client.VariableSetVariables.BatchUpsert(ctx, "varset-123", []*VariableSetVariableCreateOptions{
+			{Key: String("broken"), Value: String("x"), Category: Category(CategoryTerraform)},
+			{Key: String("broken"), Value: String("y"), Category: Category(CategoryEnv)},
+		})
+		require.Len(t, vs, 2)
+		assert.Nil(t, vs[0])
+		assert.Nil(t, vs[1])
+
+		var upsertErr *BatchError
+		require.True(t, errors.As(err, &upsertErr))
+		assert.Len(t, upsertErr.Errors, 2)
+		assert.Contains(t, upsertErr.Errors, "terraform/broken")
+		assert.Contains(t, upsertErr.Errors, "env/broken")
+	})
+
+	t.Run("with an invalid HCL value", func(t *testing.T) {
+		requests = nil
+		vs, err := client.VariableSetVariables.BatchUpsert(ctx, "varset-123", []*VariableSetVariableCreateOptions{
+			{Key: String("region"), Value: String("us-west-2"), Category: Category(CategoryTerraform)},
+			{Key: String("zones"), Value: String(`["a"`), Category: Category(CategoryTerraform), HCL: Bool(true)},
+		})
+		assert.Nil(t, vs)
+		assert.Equal(t, ErrInvalidHCLValue, err)
+		assert.Empty(t, requests)
+	})
+
+	t.Run("with duplicate keys", func(t *testing.T) {
+		vs, err := client.VariableSetVariables.BatchUpsert(ctx, "varset-123", []*VariableSetVariableCreateOptions{
+			{Key: String("region"), Value: String("a"), Category: Category(CategoryTerraform)},
+			{Key: String("region"), Value: String("b"), Category: Category(CategoryTerraform)},
+		})
+		assert.Nil(t, vs)
+		assert.ErrorIs(t, err, ErrDuplicateVariableKey)
+	})
+
+	t.Run("with an invalid variable set ID", func(t *testing.T) {
+		vs, err := client.VariableSetVariables.BatchUpsert(ctx, badIdentifier, nil)
+		assert.Nil(t, vs)
+		assert.Equal(t, ErrInvalidVariableSetID, err)
+	})
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"time"
+)
+
+// WaitBackoff returns how long to wait before the next check, given the
+// number of delays already waited, so attempt is zero for the first delay.
+type WaitBackoff func(attempt int) time.Duration
+
+// WaitOptions represents the options for polling a resource until it reaches
+// the expected state.
+type WaitOptions struct {
+	// Optional: How long to wait between checks when no Backoff is set.
+	// Defaults to 500 milliseconds.
+	PollInterval time.Duration
+
+	// Optional: How long to keep polling before giving up with
+	// ErrWaitTimeout. Defaults to waiting until the context is canceled.
+	MaxWait time.Duration
+
+	// Optional: Computes the delay between checks, overriding PollInterval.
+	Backoff WaitBackoff
+}
+
+// ExponentialWaitBackoff returns a WaitBackoff that doubles the delay every
+// five checks, starting at min and limited by max.
+func ExponentialWaitBackoff(min, max time.Duration) WaitBackoff {
+	return func(attempt int) time.Duration {
+		return backoff(float64(min.Milliseconds()), float64(max.Milliseconds()), attempt)
+	}
+}
+
+// DefaultWaitBackoff is the backoff of the methods that wait for a resource,
+// such as Plans.WaitForStatus, when their options set neither a PollInterval
+// nor a Backoff. The delay between checks doubles every five checks, from 500
+// milliseconds up to 5 seconds.
+func DefaultWaitBackoff(attempt int) time.Duration {
+	return ExponentialWaitBackoff(500*time.Millisecond, 5*time.Second)(attempt)
+}
+
+// withDefaultBackoff returns a copy of options that uses DefaultWaitBackoff
+// unless options sets a PollInterval or a Backoff.
+func withDefaultBackoff(options *WaitOptions) *WaitOptions {
+	waitOptions := WaitOptions{}
+	if options != nil {
+		waitOptions = *options
+	}
+	if waitOptions.Backoff == nil && waitOptions.PollInterval == 0 {
+		waitOptions.Backoff = DefaultWaitBackoff
+	}
+	return &waitOptions
+}
+
+func (o *WaitOptions) delay(attempt int) time.Duration {
+	if o.Backoff != nil {
+		return o.Backoff(attempt)
+	}
+	if o.PollInterval > 0 {
+		return o.PollInterval
+	}
+	return 500 * time.Millisecond
+}
+
+// wait calls check until it reports done, returns an error, the context is
+// canceled or the maximum wait time is exceeded.
+func wait(ctx context.Context, options *WaitOptions, check func() (bool, error)) error {
+	if options == nil {
+		options = &WaitOptions{}
+	}
+
+	var timeout <-chan time.Time
+	if options.MaxWait > 0 {
+		timer := time.NewTimer(options.MaxWait)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	for attempt := 0; ; attempt++ {
+		done, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeout:
+			return ErrWaitTimeout
+		case <-time.After(options.delay(attempt)):
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWait(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("when the check is done", func(t *testing.T) {
+		checks := 0
+		err := wait(ctx, &WaitOptions{PollInterval: time.Millisecond}, func() (bool, error) {
+			checks++
+			return checks == 3, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, checks)
+	})
+
+	t.Run("when the check fails", func(t *testing.T) {
+		checkErr := errors.New("check failed")
+		err := wait(ctx, nil, func() (bool, error) {
+			return false, checkErr
+		})
+		assert.Equal(t, checkErr, err)
+	})
+
+	t.Run("when the maximum wait time is exceeded", func(t *testing.T) {
+		err := wait(ctx, &WaitOptions{PollInterval: time.Millisecond, MaxWait: 20 * time.Millisecond}, func() (bool, error) {
+			return false, nil
+		})
+		assert.Equal(t, ErrWaitTimeout, err)
+	})
+
+	t.Run("when the context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		err := wait(ctx, &WaitOptions{PollInterval: time.Hour}, func() (bool, error) {
+			return false, nil
+		})
+		assert.Equal(t, context.Canceled, err)
+	})
+
+	t.Run("with a backoff", func(t *testing.T) {
+		var attempts []int
+		err := wait(ctx, &WaitOptions{
+			PollInterval: time.Hour,
+			Backoff: func(attempt int) time.Duration {
+				attempts = append(attempts, attempt)
+				return time.Millisecond
+			},
+		}, func() (bool, error) {
+			return len(attempts) == 3, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int{0, 1, 2}, attempts)
+		assert.Equal(t, 500*time.Millisecond, DefaultWaitBackoff(attempts[0]))
+	})
+}
+
+func TestExponentialWaitBackoff(t *testing.T) {
+	b := ExponentialWaitBackoff(500*time.Millisecond, 2*time.Second)
+
+	assert.Equal(t, 500*time.Millisecond, b(0))
+	assert.Equal(t, time.Second, b(5))
+	assert.Equal(t, 2*time.Second, b(10))
+	assert.Equal(t, 2*time.Second, b(100))
+}
+
+func TestWithDefaultBackoff(t *testing.T) {
+	t.Run("without options", func(t *testing.T) {
+		o := withDefaultBackoff(nil)
+		assert.Equal(t, 500*time.Millisecond, o.delay(0))
+		assert.Equal(t, 5*time.Second, o.delay(100))
+	})
+
+	t.Run("with a poll interval", func(t *testing.T) {
+		options := &WaitOptions{PollInterval: time.Second, MaxWait: time.Minute}
+		o := withDefaultBackoff(options)
+		assert.Nil(t, o.Backoff)
+		assert.Equal(t, time.Second, o.delay(100))
+		assert.Equal(t, time.Minute, o.MaxWait)
+	})
+
+	t.Run("leaves the options of the caller as is", func(t *testing.T) {
+		options := &WaitOptions{MaxWait: time.Minute}
+		o := withDefaultBackoff(options)
+		assert.NotNil(t, o.Backoff)
+		assert.Nil(t, options.Backoff)
+	})
+}
@@ -49,6 +49,14 @@ type Workspaces interface {
 	// UpdateByID updates the settings of an existing workspace.
 	UpdateByID(ctx context.Context, workspaceID string, options WorkspaceUpdateOptions) (*Workspace, error)
 
+	// UpdateDescription sets the description of a workspace, leaving its other
+	// settings unchanged.
+	UpdateDescription(ctx context.Context, workspaceID string, description string) (*Workspace, error)
+
+	// ListByDescription lists the workspaces of an organization whose
+	// description contains the given text, ignoring case.
+	ListByDescription(ctx context.Context, organization string, description string, options *WorkspaceListOptions) ([]*Workspace, error)
+
 	// Delete a workspace by its name.
 	Delete(ctx context.Context, organization string, workspace string) error
 
@@ -76,6 +84,9 @@ type Workspaces interface {
 	// ForceUnlock a workspace by its ID.
 	ForceUnlock(ctx context.Context, workspaceID string) (*Workspace, error)
 
+	// WaitUntilUnlocked polls a workspace until it is no longer locked.
+	WaitUntilUnlocked(ctx context.Context, workspaceID string, options *WaitOptions) error
+
 	// AssignSSHKey to a workspace.
 	AssignSSHKey(ctx context.Context, workspaceID string, options WorkspaceAssignSSHKeyOptions) (*Workspace, error)
 
@@ -85,6 +96,10 @@ type Workspaces interface {
 	// ListRemoteStateConsumers reads the remote state consumers for a workspace.
 	ListRemoteStateConsumers(ctx context.Context, workspaceID string, options *RemoteStateConsumersListOptions) (*WorkspaceList, error)
 
+	// ListRemoteStateConsumersWithDetails reads all pages of the remote state
+	// consumers for a workspace.
+	ListRemoteStateConsumersWithDetails(ctx context.Context, workspaceID string, options *RemoteStateConsumersListOptions) ([]*Workspace, error)
+
 	// AddRemoteStateConsumers adds remote state consumers to a workspace.
 	AddRemoteStateConsumers(ctx context.Context, workspaceID string, options WorkspaceAddRemoteStateConsumersOptions) error
 
@@ -95,6 +110,10 @@ type Workspaces interface {
 	// to match the workspaces in the update options.
 	UpdateRemoteStateConsumers(ctx context.Context, workspaceID string, options WorkspaceUpdateRemoteStateConsumersOptions) error
 
+	// MigrateRemoteStateSharing replaces the deprecated global remote state
+	// sharing of a workspace with an explicit list of remote state consumers.
+	MigrateRemoteStateSharing(ctx context.Context, workspaceID string) (*RemoteStateSharingMigration, error)
+
 	// ListTags reads the tags for a workspace.
 	ListTags(ctx context.Context, workspaceID string, options *WorkspaceTagListOptions) (*TagList, error)
 
@@ -104,6 +123,43 @@ type Workspaces interface {
 	// RemoveTags removes tags from a workspace
 	RemoveTags(ctx context.Context, workspaceID string, options WorkspaceRemoveTagsOptions) error
 
+	// AddTagsByFilter appends tags to every workspace in an organization
+	// matching the given list options.
+	AddTagsByFilter(ctx context.Context, organization string, filter WorkspaceListOptions, tags []string, options *WorkspaceTagsByFilterOptions) ([]*WorkspaceTagsByFilterResult, error)
+
+	// RemoveTagsByFilter removes tags from every workspace in an organization
+	// matching the given list options.
+	RemoveTagsByFilter(ctx context.Context, organization string, filter WorkspaceListOptions, tags []string, options *WorkspaceTagsByFilterOptions) ([]*WorkspaceTagsByFilterResult, error)
+
+	// ListEffectiveTagBindings lists the tag bindings that apply to a
+	// workspace, including the ones inherited from its project.
+	ListEffectiveTagBindings(ctx context.Context, workspaceID string) ([]*EffectiveTagBinding, error)
+
+	// ListPolicySets lists every policy set enforced on a workspace, whether
+	// it is attached directly, through the workspace's project or globally.
+	ListPolicySets(ctx context.Context, workspaceID string, options *PolicySetListOptions) ([]*WorkspacePolicySet, error)
+
+	// ReadRunStatistics computes aggregate statistics about the recent runs
+	// of a workspace.
+	ReadRunStatistics(ctx context.Context, workspaceID string, options RunStatisticsOptions) (*RunStatistics, error)
+
+	// LatestSuccessfulRun reads the most recent run of a workspace that was
+	// applied, or planned and finished without changes to apply.
+	LatestSuccessfulRun(ctx context.Context, workspaceID string) (*Run, error)
+
+	// ReadCurrentRun reads the current run of a workspace, returning
+	// ErrNoCurrentRun when the workspace has none.
+	ReadCurrentRun(ctx context.Context, workspaceID string) (*Run, error)
+
+	// ListNotificationConfigurations lists the notification configurations
+	// of a workspace.
+	ListNotificationConfigurations(ctx context.Context, workspaceID string, options *NotificationConfigurationListOptions) (*NotificationConfigurationList, error)
+
+	// TransferToOrganization moves a workspace to another organization. The
+	// API does not support this, so it always returns an error wrapping
+	// ErrNotSupported.
+	TransferToOrganization(ctx context.Context, workspaceID, targetOrganization string, options WorkspaceTransferOptions) (*Workspace, error)
+
 	// ReadDataRetentionPolicy reads a workspace's data retention policy
 	// **Note: This functionality is only available in Terraform Enterprise.**
 	ReadDataRetentionPolicy(ctx context.Context, workspaceID string) (*DataRetentionPolicy, error)
@@ -154,6 +210,7 @@ type Workspace struct {
 	FileTriggersEnabled        bool                            `jsonapi:"attr,file-triggers-enabled"`
 	GlobalRemoteState          bool                            `jsonapi:"attr,global-remote-state"`
 	Locked                     bool                            `jsonapi:"attr,locked"`
+	LockedReason               string                          `jsonapi:"attr,locked-reason"`
 	MigrationEnvironment       string                          `jsonapi:"attr,migration-environment"`
 	Name                       string                          `jsonapi:"attr,name"`
 	Operations                 bool                            `jsonapi:"attr,operations"`
@@ -284,6 +341,11 @@ type WorkspaceReadOptions struct {
 	// Optional: A list of relations to include.
 	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/workspaces#available-related-resources
 	Include []WSIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The ETag of a previous read of the workspace, as captured
+	// with ContextWithETag. When the workspace has not changed since,
+	// ErrNotModified is returned instead.
+	IfNoneMatch string `url:"-"`
 }
 
 // WorkspaceListOptions represents the options for listing workspaces.
@@ -291,6 +353,7 @@ type WorkspaceListOptions struct {
 	ListOptions
 
 	// Optional: A search string (partial workspace name) used to filter the results.
+	// The API doesn't search descriptions, see Workspaces.ListByDescription.
 	Search string `url:"search[name],omitempty"`
 
 	// Optional: A search string (comma-separated tag names) used to filter the results.
@@ -334,7 +397,7 @@ type WorkspaceCreateOptions struct {
 	AutoApply *bool `jsonapi:"attr,auto-apply,omitempty"`
 
 	// Optional: Whether to automatically apply changes for runs that are created by run triggers
-	// from another workspace.
+	// from another workspace, independently of AutoApply.
 	AutoApplyRunTrigger *bool `jsonapi:"attr,auto-apply-run-trigger,omitempty"`
 
 	// Optional: The time after which an automatic destroy run will be queued
@@ -484,7 +547,7 @@ type WorkspaceUpdateOptions struct {
 	AutoApply *bool `jsonapi:"attr,auto-apply,omitempty"`
 
 	// Optional: Whether to automatically apply changes for runs that are created by run triggers
-	// from another workspace.
+	// from another workspace, independently of AutoApply.
 	AutoApplyRunTrigger *bool `jsonapi:"attr,auto-apply-run-trigger,omitempty"`
 
 	// Optional: The time after which an automatic destroy run will be queued
@@ -582,6 +645,10 @@ type WorkspaceLockOptions struct {
 	Reason *string `jsonapi:"attr,reason,omitempty"`
 }
 
+// WorkspaceTransferOptions represents the options for moving a workspace to
+// another organization.
+type WorkspaceTransferOptions struct{}
+
 // workspaceRemoveVCSConnectionOptions
 type workspaceRemoveVCSConnectionOptions struct {
 	ID      string          `jsonapi:"primary,workspaces"`
@@ -614,8 +681,15 @@ type workspaceUnassignSSHKeyOptions struct {
 	SSHKeyID *string `jsonapi:"attr,id"`
 }
 
+// RemoteStateConsumersListOptions represents the options for listing the
+// remote state consumers of a workspace.
 type RemoteStateConsumersListOptions struct {
 	ListOptions
+
+	// Optional: Only return the IDs of the consumers, leaving the other
+	// workspace attributes unset. This is cheaper when the details of the
+	// consumers are not needed.
+	IDsOnly bool `url:"-"`
 }
 
 // WorkspaceAddRemoteStateConsumersOptions represents the options for adding remote state consumers
@@ -639,6 +713,19 @@ type WorkspaceUpdateRemoteStateConsumersOptions struct {
 	Workspaces []*Workspace
 }
 
+// RemoteStateSharingMigration represents the changes made when migrating a
+// workspace away from global remote state sharing.
+type RemoteStateSharingMigration struct {
+	// Migrated reports whether the workspace shared its state globally and
+	// was migrated. Workspaces already using explicit consumers are left
+	// unchanged.
+	Migrated bool
+
+	// AddedConsumers holds the workspaces that were added as remote state
+	// consumers so they keep access to the workspace's state.
+	AddedConsumers []*Workspace
+}
+
 type WorkspaceTagListOptions struct {
 	ListOptions
 
@@ -655,6 +742,91 @@ type WorkspaceRemoveTagsOptions struct {
 	Tags []*Tag
 }
 
+// WorkspaceTagsByFilterOptions represents the options for adding or removing
+// tags across all the workspaces matching a filter.
+type WorkspaceTagsByFilterOptions struct {
+	// Optional: When true, the matching workspaces are returned but their
+	// tags are left untouched.
+	DryRun bool
+}
+
+// WorkspaceTagsByFilterResult represents the outcome of adding or removing
+// tags on a single workspace matched by a filter.
+type WorkspaceTagsByFilterResult struct {
+	Workspace *Workspace
+
+	// Applied is true when the tags were changed on the workspace. It is
+	// always false for a dry run.
+	Applied bool
+
+	// Err holds the error returned when changing the tags of the workspace.
+	Err error
+}
+
+// PolicySetSource represents how a policy set is enforced on a workspace.
+type PolicySetSource string
+
+// List all available policy set sources.
+const (
+	PolicySetSourceGlobal    PolicySetSource = "global"
+	PolicySetSourceProject   PolicySetSource = "project"
+	PolicySetSourceWorkspace PolicySetSource = "workspace"
+)
+
+// WorkspacePolicySet represents a policy set enforced on a workspace.
+type WorkspacePolicySet struct {
+	PolicySet *PolicySet
+
+	// Source is how the policy set is enforced on the workspace. A policy set
+	// attached both directly and through the project has the workspace
+	// source.
+	Source PolicySetSource
+
+	// EnforcementLevels holds the distinct enforcement levels of the
+	// individually managed policies in the policy set. It is empty for policy
+	// sets sourced from a VCS repository.
+	EnforcementLevels []EnforcementLevel
+}
+
+// RunStatisticsOptions represents the options for reading the run statistics
+// of a workspace.
+type RunStatisticsOptions struct {
+	// Optional: The number of days, counting back from now, of runs to
+	// include. Defaults to 30.
+	Days int
+}
+
+// RunStatistics represents aggregate statistics about the recent runs of a
+// workspace.
+type RunStatistics struct {
+	// Days is the number of days of runs the statistics were computed from.
+	Days int
+
+	// TotalRuns is the number of runs created in the period.
+	TotalRuns int
+
+	// SuccessfulRuns is the number of runs that were applied, or planned
+	// and finished without needing an apply.
+	SuccessfulRuns int
+
+	// FailedRuns is the number of runs that errored.
+	FailedRuns int
+
+	// SuccessRate and FailureRate are the fractions of completed runs that
+	// succeeded and failed. Canceled, discarded and in-progress runs are not
+	// counted as completed. Both are 0 when no run has completed.
+	SuccessRate float64
+	FailureRate float64
+
+	// AveragePlanDuration is the mean time spent planning, over the runs
+	// that finished planning.
+	AveragePlanDuration time.Duration
+
+	// AverageApplyDuration is the mean time spent applying, over the runs
+	// that were applied.
+	AverageApplyDuration time.Duration
+}
+
 // List all the workspaces within an organization.
 func (s *workspaces) List(ctx context.Context, organization string, options *WorkspaceListOptions) (*WorkspaceList, error) {
 	if !validStringID(&organization) {
@@ -729,6 +901,9 @@ func (s *workspaces) ReadWithOptions(ctx context.Context, organization, workspac
 	if err != nil {
 		return nil, err
 	}
+	if options != nil && options.IfNoneMatch != "" {
+		req.Header.Set("If-None-Match", options.IfNoneMatch)
+	}
 
 	w := &Workspace{}
 	err = req.Do(ctx, w)
@@ -759,6 +934,9 @@ func (s *workspaces) ReadByIDWithOptions(ctx context.Context, workspaceID string
 	if err != nil {
 		return nil, err
 	}
+	if options != nil && options.IfNoneMatch != "" {
+		req.Header.Set("If-None-Match", options.IfNoneMatch)
+	}
 
 	w := &Workspace{}
 	err = req.Do(ctx, w)
@@ -849,6 +1027,54 @@ func (s *workspaces) UpdateByID(ctx context.Context, workspaceID string, options
 	return w, nil
 }
 
+// UpdateDescription sets the description of a workspace by its ID.
+func (s *workspaces) UpdateDescription(ctx context.Context, workspaceID, description string) (*Workspace, error) {
+	return s.UpdateByID(ctx, workspaceID, WorkspaceUpdateOptions{
+		Description: String(description),
+	})
+}
+
+// ListByDescription pages through the workspaces of an organization and
+// returns the ones whose description contains the given text. The API
+// cannot search descriptions, so the workspaces are filtered client side;
+// the other list options still narrow down the workspaces requested.
+func (s *workspaces) ListByDescription(ctx context.Context, organization, description string, options *WorkspaceListOptions) ([]*Workspace, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+	if !validString(&description) {
+		return nil, ErrRequiredDescription
+	}
+
+	listOptions := &WorkspaceListOptions{}
+	if options != nil {
+		*listOptions = *options
+	}
+
+	needle := strings.ToLower(description)
+
+	var result []*Workspace
+	for {
+		wl, err := s.List(ctx, organization, listOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, w := range wl.Items {
+			if strings.Contains(strings.ToLower(w.Description), needle) {
+				result = append(result, w)
+			}
+		}
+
+		if wl.Pagination == nil || wl.NextPage == 0 {
+			break
+		}
+		listOptions.PageNumber = wl.NextPage
+	}
+
+	return result, nil
+}
+
 // Delete a workspace by its name.
 func (s *workspaces) Delete(ctx context.Context, organization, workspace string) error {
 	if !validStringID(&organization) {
@@ -1037,6 +1263,25 @@ func (s *workspaces) ForceUnlock(ctx context.Context, workspaceID string) (*Work
 	return w, nil
 }
 
+// WaitUntilUnlocked reads the workspace until it is unlocked. The delay
+// between reads follows DefaultWaitBackoff, unless options sets a poll
+// interval or backoff. ErrWaitTimeout is returned
+// when options.MaxWait is exceeded, and the error of the context when it is
+// canceled.
+func (s *workspaces) WaitUntilUnlocked(ctx context.Context, workspaceID string, options *WaitOptions) error {
+	if !validStringID(&workspaceID) {
+		return ErrInvalidWorkspaceID
+	}
+
+	return wait(ctx, withDefaultBackoff(options), func() (bool, error) {
+		w, err := s.ReadByID(ctx, workspaceID)
+		if err != nil {
+			return false, err
+		}
+		return !w.Locked, nil
+	})
+}
+
 // AssignSSHKey to a workspace.
 func (s *workspaces) AssignSSHKey(ctx context.Context, workspaceID string, options WorkspaceAssignSSHKeyOptions) (*Workspace, error) {
 	if !validStringID(&workspaceID) {
@@ -1090,7 +1335,7 @@ func (s *workspaces) ListRemoteStateConsumers(ctx context.Context, workspaceID s
 
 	u := fmt.Sprintf("workspaces/%s/relationships/remote-state-consumers", url.QueryEscape(workspaceID))
 
-	req, err := s.client.NewRequest("GET", u, options)
+	req, err := s.client.NewRequestWithAdditionalQueryParams("GET", u, options, options.buildQueryString())
 	if err != nil {
 		return nil, err
 	}
@@ -1104,6 +1349,34 @@ func (s *workspaces) ListRemoteStateConsumers(ctx context.Context, workspaceID s
 	return wl, nil
 }
 
+// ListRemoteStateConsumersWithDetails returns the remote state consumers of a
+// workspace across all pages, including their names and organizations unless
+// IDsOnly is set.
+func (s *workspaces) ListRemoteStateConsumersWithDetails(ctx context.Context, workspaceID string, options *RemoteStateConsumersListOptions) ([]*Workspace, error) {
+	// Page through a copy of the options, leaving those of the caller as is.
+	opts := RemoteStateConsumersListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	var consumers []*Workspace
+	for {
+		wl, err := s.ListRemoteStateConsumers(ctx, workspaceID, &opts)
+		if err != nil {
+			return nil, err
+		}
+
+		consumers = append(consumers, wl.Items...)
+
+		if wl.Pagination == nil || wl.NextPage == 0 {
+			break
+		}
+		opts.PageNumber = wl.NextPage
+	}
+
+	return consumers, nil
+}
+
 // AddRemoteStateConsumere adds the remote state consumers to a given workspace.
 func (s *workspaces) AddRemoteStateConsumers(ctx context.Context, workspaceID string, options WorkspaceAddRemoteStateConsumersOptions) error {
 	if !validStringID(&workspaceID) {
@@ -1158,6 +1431,82 @@ func (s *workspaces) UpdateRemoteStateConsumers(ctx context.Context, workspaceID
 	return req.Do(ctx, nil)
 }
 
+// MigrateRemoteStateSharing replaces the deprecated global remote state
+// sharing of a workspace with an explicit list of remote state consumers.
+// Every other workspace of the organization is added as a consumer before
+// global sharing is disabled, so no workspace loses access to the state.
+func (s *workspaces) MigrateRemoteStateSharing(ctx context.Context, workspaceID string) (*RemoteStateSharingMigration, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	w, err := s.ReadByID(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	migration := &RemoteStateSharingMigration{}
+	if !w.GlobalRemoteState {
+		return migration, nil
+	}
+
+	consumers := make(map[string]bool)
+	consumerOptions := &RemoteStateConsumersListOptions{}
+	for {
+		wl, err := s.ListRemoteStateConsumers(ctx, w.ID, consumerOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range wl.Items {
+			consumers[c.ID] = true
+		}
+
+		if wl.Pagination == nil || wl.NextPage == 0 {
+			break
+		}
+		consumerOptions.PageNumber = wl.NextPage
+	}
+
+	listOptions := &WorkspaceListOptions{}
+	for {
+		wl, err := s.List(ctx, w.Organization.Name, listOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ws := range wl.Items {
+			if ws.ID != w.ID && !consumers[ws.ID] {
+				migration.AddedConsumers = append(migration.AddedConsumers, ws)
+			}
+		}
+
+		if wl.Pagination == nil || wl.NextPage == 0 {
+			break
+		}
+		listOptions.PageNumber = wl.NextPage
+	}
+
+	if len(migration.AddedConsumers) > 0 {
+		err = s.AddRemoteStateConsumers(ctx, w.ID, WorkspaceAddRemoteStateConsumersOptions{
+			Workspaces: migration.AddedConsumers,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = s.UpdateByID(ctx, w.ID, WorkspaceUpdateOptions{
+		GlobalRemoteState: Bool(false),
+	})
+	if err != nil {
+		return nil, err
+	}
+	migration.Migrated = true
+
+	return migration, nil
+}
+
 // ListTags returns the tags for a given workspace.
 func (s *workspaces) ListTags(ctx context.Context, workspaceID string, options *WorkspaceTagListOptions) (*TagList, error) {
 	if !validStringID(&workspaceID) {
@@ -1216,6 +1565,356 @@ func (s *workspaces) RemoveTags(ctx context.Context, workspaceID string, options
 	return req.Do(ctx, nil)
 }
 
+// AddTagsByFilter adds a list of tags to every workspace in an organization
+// matching the given list options. An error is only returned when the
+// workspaces could not be listed; failures for individual workspaces are
+// reported in the returned results.
+func (s *workspaces) AddTagsByFilter(ctx context.Context, organization string, filter WorkspaceListOptions, tags []string, options *WorkspaceTagsByFilterOptions) ([]*WorkspaceTagsByFilterResult, error) {
+	return s.updateTagsByFilter(ctx, organization, filter, tags, options, func(workspaceID string, t []*Tag) error {
+		return s.AddTags(ctx, workspaceID, WorkspaceAddTagsOptions{Tags: t})
+	})
+}
+
+// RemoveTagsByFilter removes a list of tags from every workspace in an
+// organization matching the given list options. An error is only returned
+// when the workspaces could not be listed; failures for individual workspaces
+// are reported in the returned results.
+func (s *workspaces) RemoveTagsByFilter(ctx context.Context, organization string, filter WorkspaceListOptions, tags []string, options *WorkspaceTagsByFilterOptions) ([]*WorkspaceTagsByFilterResult, error) {
+	return s.updateTagsByFilter(ctx, organization, filter, tags, options, func(workspaceID string, t []*Tag) error {
+		return s.RemoveTags(ctx, workspaceID, WorkspaceRemoveTagsOptions{Tags: t})
+	})
+}
+
+func (s *workspaces) updateTagsByFilter(ctx context.Context, organization string, filter WorkspaceListOptions, tags []string, options *WorkspaceTagsByFilterOptions, update func(string, []*Tag) error) ([]*WorkspaceTagsByFilterResult, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+	if err := validateTagNames(tags); err != nil {
+		return nil, err
+	}
+
+	t := make([]*Tag, 0, len(tags))
+	for _, name := range tags {
+		t = append(t, &Tag{Name: name})
+	}
+
+	// Every matching workspace is listed before any is updated, as updating
+	// the tags of a workspace can change the result of a filter on tags.
+	var results []*WorkspaceTagsByFilterResult
+	for {
+		wl, err := s.List(ctx, organization, &filter)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, w := range wl.Items {
+			results = append(results, &WorkspaceTagsByFilterResult{Workspace: w})
+		}
+
+		if wl.Pagination == nil || wl.NextPage == 0 {
+			break
+		}
+		filter.PageNumber = wl.NextPage
+	}
+
+	if options != nil && options.DryRun {
+		return results, nil
+	}
+
+	for _, result := range results {
+		result.Err = update(result.Workspace.ID, t)
+		result.Applied = result.Err == nil
+	}
+
+	return results, nil
+}
+
+// ListEffectiveTagBindings lists the tag bindings that apply to a workspace,
+// including the ones inherited from its project.
+func (s *workspaces) ListEffectiveTagBindings(ctx context.Context, workspaceID string) ([]*EffectiveTagBinding, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	u := fmt.Sprintf("workspaces/%s/effective-tag-bindings", url.QueryEscape(workspaceID))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	etbl := &effectiveTagBindingList{}
+	err = req.Do(ctx, etbl)
+	if err != nil {
+		return nil, err
+	}
+	etbl.setSources(TagBindingSourceWorkspace)
+
+	return etbl.Items, nil
+}
+
+// ListPolicySets lists every policy set enforced on a workspace, whether it is
+// attached directly, through the workspace's project or globally. Policy sets
+// from which the workspace is excluded are left out.
+func (s *workspaces) ListPolicySets(ctx context.Context, workspaceID string, options *PolicySetListOptions) ([]*WorkspacePolicySet, error) {
+	w, err := s.ReadByID(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the relations needed to find the source of each policy set to a
+	// copy of the relations the caller asked for.
+	listOptions := &PolicySetListOptions{}
+	if options != nil {
+		*listOptions = *options
+	}
+	include := append([]PolicySetIncludeOpt(nil), listOptions.Include...)
+	include = append(include,
+		PolicySetPolicies,
+		PolicySetProjects,
+		PolicySetWorkspaces,
+		PolicySetWorkspaceExclusions,
+	)
+	seen := make(map[PolicySetIncludeOpt]bool)
+	listOptions.Include = nil
+	for _, i := range include {
+		if !seen[i] {
+			seen[i] = true
+			listOptions.Include = append(listOptions.Include, i)
+		}
+	}
+
+	var projectID string
+	if w.Project != nil {
+		projectID = w.Project.ID
+	}
+
+	var result []*WorkspacePolicySet
+	for {
+		psl, err := s.client.PolicySets.List(ctx, w.Organization.Name, listOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ps := range psl.Items {
+			source, ok := workspacePolicySetSource(ps, w.ID, projectID)
+			if !ok {
+				continue
+			}
+
+			wps := &WorkspacePolicySet{
+				PolicySet: ps,
+				Source:    source,
+			}
+			seen := make(map[EnforcementLevel]bool)
+			for _, p := range ps.Policies {
+				for _, e := range p.Enforce {
+					if e != nil && !seen[e.Mode] {
+						seen[e.Mode] = true
+						wps.EnforcementLevels = append(wps.EnforcementLevels, e.Mode)
+					}
+				}
+			}
+			result = append(result, wps)
+		}
+
+		if psl.Pagination == nil || psl.NextPage == 0 {
+			break
+		}
+		listOptions.PageNumber = psl.NextPage
+	}
+
+	return result, nil
+}
+
+// ReadRunStatistics computes aggregate statistics about the recent runs of a
+// workspace. The API does not provide these, so they are computed by paging
+// through the workspace's runs, newest first, until the period is covered.
+func (s *workspaces) ReadRunStatistics(ctx context.Context, workspaceID string, options RunStatisticsOptions) (*RunStatistics, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	days := options.Days
+	if days == 0 {
+		days = 30
+	}
+	since := time.Now().AddDate(0, 0, -days)
+
+	var runs []*Run
+	listOptions := &RunListOptions{}
+	for {
+		rl, err := s.client.Runs.List(ctx, workspaceID, listOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		done := false
+		for _, r := range rl.Items {
+			if r.CreatedAt.Before(since) {
+				done = true
+				break
+			}
+			runs = append(runs, r)
+		}
+
+		if done || rl.Pagination == nil || rl.NextPage == 0 {
+			break
+		}
+		listOptions.PageNumber = rl.NextPage
+	}
+
+	stats := newRunStatistics(runs)
+	stats.Days = days
+
+	return stats, nil
+}
+
+// LatestSuccessfulRun lists the runs of a workspace filtered to the applied
+// and planned and finished statuses, newest first, and returns the first.
+func (s *workspaces) LatestSuccessfulRun(ctx context.Context, workspaceID string) (*Run, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	rl, err := s.client.Runs.List(ctx, workspaceID, &RunListOptions{
+		ListOptions: ListOptions{PageSize: 1},
+		Status:      strings.Join([]string{string(RunApplied), string(RunPlannedAndFinished)}, ","),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rl.Items) == 0 {
+		return nil, ErrResourceNotFound
+	}
+
+	return rl.Items[0], nil
+}
+
+// newRunStatistics computes the statistics of the given runs.
+func newRunStatistics(runs []*Run) *RunStatistics {
+	stats := &RunStatistics{TotalRuns: len(runs)}
+
+	var planTotal, applyTotal time.Duration
+	var plans, applies int
+	for _, r := range runs {
+		switch r.Status {
+		case RunApplied, RunPlannedAndFinished, RunPlannedAndSaved:
+			stats.SuccessfulRuns++
+		case RunErrored:
+			stats.FailedRuns++
+		}
+
+		ts := r.StatusTimestamps
+		if ts == nil {
+			continue
+		}
+
+		plannedAt := ts.PlannedAt
+		if plannedAt.IsZero() {
+			plannedAt = ts.PlannedAndFinishedAt
+		}
+		if plannedAt.IsZero() {
+			plannedAt = ts.PlannedAndSavedAt
+		}
+		if !ts.PlanningAt.IsZero() && !plannedAt.IsZero() {
+			planTotal += plannedAt.Sub(ts.PlanningAt)
+			plans++
+		}
+
+		if !ts.ApplyingAt.IsZero() && !ts.AppliedAt.IsZero() {
+			applyTotal += ts.AppliedAt.Sub(ts.ApplyingAt)
+			applies++
+		}
+	}
+
+	if completed := stats.SuccessfulRuns + stats.FailedRuns; completed > 0 {
+		stats.SuccessRate = float64(stats.SuccessfulRuns) / float64(completed)
+		stats.FailureRate = float64(stats.FailedRuns) / float64(completed)
+	}
+	if plans > 0 {
+		stats.AveragePlanDuration = planTotal / time.Duration(plans)
+	}
+	if applies > 0 {
+		stats.AverageApplyDuration = applyTotal / time.Duration(applies)
+	}
+
+	return stats
+}
+
+// workspacePolicySetSource returns how the given policy set is enforced on a
+// workspace, and false when it isn't enforced on the workspace at all.
+func workspacePolicySetSource(ps *PolicySet, workspaceID, projectID string) (PolicySetSource, bool) {
+	for _, w := range ps.Workspaces {
+		if w.ID == workspaceID {
+			return PolicySetSourceWorkspace, true
+		}
+	}
+	for _, w := range ps.WorkspaceExclusions {
+		if w.ID == workspaceID {
+			return "", false
+		}
+	}
+	if projectID != "" {
+		for _, p := range ps.Projects {
+			if p.ID == projectID {
+				return PolicySetSourceProject, true
+			}
+		}
+	}
+	if ps.Global {
+		return PolicySetSourceGlobal, true
+	}
+
+	return "", false
+}
+
+// ReadCurrentRun reads the current run of a workspace by including it when
+// reading the workspace.
+func (s *workspaces) ReadCurrentRun(ctx context.Context, workspaceID string) (*Run, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	w, err := s.ReadByIDWithOptions(ctx, workspaceID, &WorkspaceReadOptions{
+		Include: []WSIncludeOpt{WSCurrentRun},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if w.CurrentRun == nil {
+		return nil, ErrNoCurrentRun
+	}
+
+	return w.CurrentRun, nil
+}
+
+// ListNotificationConfigurations lists the notification configurations of a
+// workspace, including their triggers and most recent delivery responses.
+func (s *workspaces) ListNotificationConfigurations(ctx context.Context, workspaceID string, options *NotificationConfigurationListOptions) (*NotificationConfigurationList, error) {
+	return s.client.NotificationConfigurations.List(ctx, workspaceID, options)
+}
+
+// TransferToOrganization would move a workspace to another organization, but
+// the API can only move workspaces between projects of the same organization.
+// To migrate a workspace, create a workspace in the target organization and
+// copy its settings, variables and current state version, e.g. with
+// StateVersions.Download and StateVersions.Create.
+func (s *workspaces) TransferToOrganization(ctx context.Context, workspaceID, targetOrganization string, options WorkspaceTransferOptions) (*Workspace, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+	if !validStringID(&targetOrganization) {
+		return nil, ErrInvalidOrg
+	}
+
+	return nil, ErrWorkspaceTransferNotSupported
+}
+
 func (s *workspaces) ReadDataRetentionPolicy(ctx context.Context, workspaceID string) (*DataRetentionPolicy, error) {
 	if !validStringID(&workspaceID) {
 		return nil, ErrInvalidWorkspaceID
@@ -1304,6 +2003,14 @@ func (o WorkspaceCreateOptions) valid() error {
 		o.FileTriggersEnabled != nil && *o.FileTriggersEnabled {
 		return ErrUnsupportedBothTagsRegexAndFileTriggersEnabled
 	}
+	if o.VCSRepo != nil {
+		if err := o.VCSRepo.validConnection(); err != nil {
+			return err
+		}
+		if !validString(o.VCSRepo.OAuthTokenID) && !validString(o.VCSRepo.GHAInstallationID) {
+			return ErrRequiredOauthTokenOrGithubAppInstallationID
+		}
+	}
 
 	return nil
 }
@@ -1335,6 +2042,11 @@ func (o WorkspaceUpdateOptions) valid() error {
 		o.FileTriggersEnabled != nil && *o.FileTriggersEnabled {
 		return ErrUnsupportedBothTagsRegexAndFileTriggersEnabled
 	}
+	if o.VCSRepo != nil {
+		if err := o.VCSRepo.validConnection(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -1405,6 +2117,28 @@ func (o WorkspaceRemoveTagsOptions) valid() error {
 	return nil
 }
 
+func validateTagNames(tags []string) error {
+	if len(tags) == 0 {
+		return ErrMissingTagIdentifier
+	}
+	for _, name := range tags {
+		if name == "" {
+			return ErrMissingTagIdentifier
+		}
+	}
+
+	return nil
+}
+
+// buildQueryString requests an empty sparse fieldset when only the IDs of the
+// consumers are needed.
+func (o *RemoteStateConsumersListOptions) buildQueryString() map[string][]string {
+	if o == nil || !o.IDsOnly {
+		return nil
+	}
+	return map[string][]string{"fields[workspaces]": {""}}
+}
+
 func (o *WorkspaceListOptions) valid() error {
 	return nil
 }
@@ -1413,6 +2147,22 @@ func (o *WorkspaceReadOptions) valid() error {
 	return nil
 }
 
+func (o RunStatisticsOptions) valid() error {
+	if o.Days < 0 {
+		return ErrInvalidRunStatisticsDays
+	}
+	return nil
+}
+
+// validConnection checks the repository is not connected through both an
+// OAuth token and a GitHub App installation.
+func (o *VCSRepoOptions) validConnection() error {
+	if validString(o.OAuthTokenID) && validString(o.GHAInstallationID) {
+		return ErrUnsupportedBothOauthTokenAndGithubAppInstallationID
+	}
+	return nil
+}
+
 func tagRegexDefined(options *VCSRepoOptions) bool {
 	if options == nil {
 		return false
@@ -10,9 +10,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -343,6 +347,39 @@ func TestWorkspacesCreateTableDriven(t *testing.T) {
 				assert.EqualError(t, err, ErrUnsupportedBothTagsRegexAndFileTriggersEnabled.Error())
 			},
 		},
+		{
+			scenario: "when options include both an OAuth token and a GitHub App installation an error is returned",
+			options: &WorkspaceTableOptions{
+				createOptions: &WorkspaceCreateOptions{
+					Name: String("foobar"),
+					VCSRepo: &VCSRepoOptions{
+						Identifier:        String("hashicorp/go-tfe"),
+						OAuthTokenID:      String("ot-1"),
+						GHAInstallationID: String("ghain-1"),
+					},
+				},
+			},
+			assertion: func(w *Workspace, options *WorkspaceTableOptions, err error) {
+				assert.Nil(t, w)
+				assert.EqualError(t, err, ErrUnsupportedBothOauthTokenAndGithubAppInstallationID.Error())
+			},
+		},
+		{
+			scenario: "when options include neither an OAuth token nor a GitHub App installation an error is returned",
+			options: &WorkspaceTableOptions{
+				createOptions: &WorkspaceCreateOptions{
+					Name: String("foobar"),
+					VCSRepo: &VCSRepoOptions{
+						Identifier:        String("hashicorp/go-tfe"),
+						IngressSubmodules: Bool(true),
+					},
+				},
+			},
+			assertion: func(w *Workspace, options *WorkspaceTableOptions, err error) {
+				assert.Nil(t, w)
+				assert.EqualError(t, err, ErrRequiredOauthTokenOrGithubAppInstallationID.Error())
+			},
+		},
 		{
 			scenario: "when options include both non-empty tags-regex and file-triggers-enabled as false an error is not returned",
 			options: &WorkspaceTableOptions{
@@ -875,7 +912,7 @@ func TestWorkspacesRead(t *testing.T) {
 			assert.Equal(t, defaultExecutionOrgTest.DefaultExecutionMode, w.ExecutionMode)
 			assert.NotEmpty(t, w.SettingOverwrites)
 			assert.Equal(t, false, *w.SettingOverwrites.ExecutionMode)
-			assert.Equal(t, false, *w.SettingOverwrites.ExecutionMode)
+			assert.Equal(t, false, *w.SettingOverwrites.AgentPool)
 		})
 	})
 }
@@ -1587,6 +1624,84 @@ func TestWorkspacesUpdateByID(t *testing.T) {
 	})
 }
 
+func TestWorkspacesUpdateDescription(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	wTest, wCleanup := createWorkspace(t, client, orgTest)
+	t.Cleanup(wCleanup)
+
+	t.Run("with a description", func(t *testing.T) {
+		w, err := client.Workspaces.UpdateDescription(ctx, wTest.ID, "owner: platform-team")
+		require.NoError(t, err)
+		assert.Equal(t, "owner: platform-team", w.Description)
+		assert.Equal(t, wTest.Name, w.Name)
+		assert.Equal(t, wTest.AutoApply, w.AutoApply)
+	})
+
+	t.Run("with an empty description", func(t *testing.T) {
+		w, err := client.Workspaces.UpdateDescription(ctx, wTest.ID, "")
+		require.NoError(t, err)
+		assert.Empty(t, w.Description)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		w, err := client.Workspaces.UpdateDescription(ctx, badIdentifier, "owner: platform-team")
+		assert.Nil(t, w)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
+func TestWorkspacesListByDescription(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	wTest1, wTest1Cleanup := createWorkspaceWithOptions(t, client, orgTest, WorkspaceCreateOptions{
+		Name:        String(randomString(t)),
+		Description: String("Owner: Platform-Team"),
+	})
+	t.Cleanup(wTest1Cleanup)
+
+	_, wTest2Cleanup := createWorkspaceWithOptions(t, client, orgTest, WorkspaceCreateOptions{
+		Name:        String(randomString(t)),
+		Description: String("owner: data-team"),
+	})
+	t.Cleanup(wTest2Cleanup)
+
+	t.Run("with a matching description", func(t *testing.T) {
+		ws, err := client.Workspaces.ListByDescription(ctx, orgTest.Name, "platform-team", &WorkspaceListOptions{
+			ListOptions: ListOptions{PageSize: 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, ws, 1)
+		assert.Equal(t, wTest1.ID, ws[0].ID)
+	})
+
+	t.Run("without a matching description", func(t *testing.T) {
+		ws, err := client.Workspaces.ListByDescription(ctx, orgTest.Name, "security-team", nil)
+		require.NoError(t, err)
+		assert.Empty(t, ws)
+	})
+
+	t.Run("without a description", func(t *testing.T) {
+		ws, err := client.Workspaces.ListByDescription(ctx, orgTest.Name, "", nil)
+		assert.Nil(t, ws)
+		assert.EqualError(t, err, ErrRequiredDescription.Error())
+	})
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		ws, err := client.Workspaces.ListByDescription(ctx, badIdentifier, "platform-team", nil)
+		assert.Nil(t, ws)
+		assert.EqualError(t, err, ErrInvalidOrg.Error())
+	})
+}
+
 func TestWorkspacesUpdateWithDefaultExecutionMode(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -1880,7 +1995,9 @@ func TestWorkspacesLock(t *testing.T) {
 	t.Run("with valid options", func(t *testing.T) {
 		require.Empty(t, wTest.LockedBy)
 
-		w, err := client.Workspaces.Lock(ctx, wTest.ID, WorkspaceLockOptions{})
+		w, err := client.Workspaces.Lock(ctx, wTest.ID, WorkspaceLockOptions{
+			Reason: String("Investigating drift"),
+		})
 		require.NoError(t, err)
 		assert.True(t, w.Locked)
 
@@ -1889,6 +2006,17 @@ func TestWorkspacesLock(t *testing.T) {
 		requireExactlyOneNotEmpty(t, w.LockedBy.Run, w.LockedBy.Team, w.LockedBy.User)
 	})
 
+	t.Run("when reading the lock holder", func(t *testing.T) {
+		w, err := client.Workspaces.ReadByIDWithOptions(ctx, wTest.ID, &WorkspaceReadOptions{
+			Include: []WSIncludeOpt{WSLockedBy},
+		})
+		require.NoError(t, err)
+		assert.True(t, w.Locked)
+		assert.Equal(t, "Investigating drift", w.LockedReason)
+		require.NotEmpty(t, w.LockedBy)
+		requireExactlyOneNotEmpty(t, w.LockedBy.Run, w.LockedBy.Team, w.LockedBy.User)
+	})
+
 	t.Run("when workspace is already locked", func(t *testing.T) {
 		_, err := client.Workspaces.Lock(ctx, wTest.ID, WorkspaceLockOptions{})
 		assert.Equal(t, ErrWorkspaceLocked, err)
@@ -1985,6 +2113,63 @@ func TestWorkspacesForceUnlock(t *testing.T) {
 	})
 }
 
+func TestWorkspacesWaitUntilUnlocked(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	lockedReads := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		mu.Lock()
+		locked := lockedReads > 0
+		if locked {
+			lockedReads--
+		}
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		fmt.Fprintf(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"web","locked":%t}}}`, locked)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+
+	t.Run("when the workspace gets unlocked", func(t *testing.T) {
+		lockedReads = 2
+		err := client.Workspaces.WaitUntilUnlocked(ctx, "ws-123", &WaitOptions{PollInterval: time.Millisecond})
+		require.NoError(t, err)
+		assert.Equal(t, 0, lockedReads)
+	})
+
+	t.Run("when the workspace stays locked", func(t *testing.T) {
+		lockedReads = 1 << 30
+		err := client.Workspaces.WaitUntilUnlocked(ctx, "ws-123", &WaitOptions{
+			PollInterval: time.Millisecond,
+			MaxWait:      20 * time.Millisecond,
+		})
+		assert.Equal(t, ErrWaitTimeout, err)
+	})
+
+	t.Run("when the context is canceled", func(t *testing.T) {
+		lockedReads = 1 << 30
+		ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+
+		err := client.Workspaces.WaitUntilUnlocked(ctx, "ws-123", &WaitOptions{PollInterval: time.Millisecond})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("with an invalid workspace ID", func(t *testing.T) {
+		err := client.Workspaces.WaitUntilUnlocked(ctx, badIdentifier, nil)
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+	})
+}
+
 func TestWorkspacesAssignSSHKey(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -2123,6 +2308,124 @@ func TestWorkspaces_AddRemoteStateConsumers(t *testing.T) {
 	})
 }
 
+func TestWorkspaces_ListRemoteStateConsumersWithDetails(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	wTest, wTestCleanup := createWorkspace(t, client, orgTest)
+	t.Cleanup(wTestCleanup)
+
+	wTest, err := client.Workspaces.Update(ctx, orgTest.Name, wTest.Name, WorkspaceUpdateOptions{
+		GlobalRemoteState: Bool(false),
+	})
+	require.NoError(t, err)
+
+	wTestConsumer1, wTestCleanupConsumer1 := createWorkspace(t, client, orgTest)
+	t.Cleanup(wTestCleanupConsumer1)
+	wTestConsumer2, wTestCleanupConsumer2 := createWorkspace(t, client, orgTest)
+	t.Cleanup(wTestCleanupConsumer2)
+
+	err = client.Workspaces.AddRemoteStateConsumers(ctx, wTest.ID, WorkspaceAddRemoteStateConsumersOptions{
+		Workspaces: []*Workspace{wTestConsumer1, wTestConsumer2},
+	})
+	require.NoError(t, err)
+
+	t.Run("across pages", func(t *testing.T) {
+		consumers, err := client.Workspaces.ListRemoteStateConsumersWithDetails(ctx, wTest.ID, &RemoteStateConsumersListOptions{
+			ListOptions: ListOptions{PageSize: 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, consumers, 2)
+
+		names := []string{consumers[0].Name, consumers[1].Name}
+		assert.ElementsMatch(t, []string{wTestConsumer1.Name, wTestConsumer2.Name}, names)
+		assert.Equal(t, orgTest.Name, consumers[0].Organization.Name)
+	})
+
+	t.Run("with IDs only", func(t *testing.T) {
+		consumers, err := client.Workspaces.ListRemoteStateConsumersWithDetails(ctx, wTest.ID, &RemoteStateConsumersListOptions{
+			IDsOnly: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, consumers, 2)
+
+		ids := []string{consumers[0].ID, consumers[1].ID}
+		assert.ElementsMatch(t, []string{wTestConsumer1.ID, wTestConsumer2.ID}, ids)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		_, err := client.Workspaces.ListRemoteStateConsumersWithDetails(ctx, badIdentifier, nil)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
+func TestWorkspaces_ListRemoteStateConsumersWithDetails_Pagination(t *testing.T) {
+	ctx := context.Background()
+
+	var queries []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(204)
+		case "/api/v2/workspaces/ws-source/relationships/remote-state-consumers":
+			queries = append(queries, r.URL.RawQuery)
+
+			page, next := 1, 2
+			if r.URL.Query().Get("page[number]") == "2" {
+				page, next = 2, 0
+			}
+			w.Header().Set("Content-Type", ContentTypeJSONAPI)
+			fmt.Fprintf(w, `{"data":[{"id":"ws-consumer-%d","type":"workspaces","attributes":{"name":"consumer-%d"},"relationships":{"organization":{"data":{"id":"my-org","type":"organizations"}}}}],"meta":{"pagination":{"current-page":%d,"next-page":%d}}}`,
+				page, page, page, next)
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "abcd1234",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	t.Run("with details", func(t *testing.T) {
+		queries = nil
+
+		consumers, err := client.Workspaces.ListRemoteStateConsumersWithDetails(ctx, "ws-source", nil)
+		require.NoError(t, err)
+		require.Len(t, consumers, 2)
+
+		assert.Equal(t, "ws-consumer-1", consumers[0].ID)
+		assert.Equal(t, "consumer-2", consumers[1].Name)
+		assert.Equal(t, "my-org", consumers[1].Organization.Name)
+		for _, q := range queries {
+			assert.NotContains(t, q, "fields")
+		}
+	})
+
+	t.Run("with IDs only", func(t *testing.T) {
+		queries = nil
+
+		options := &RemoteStateConsumersListOptions{
+			IDsOnly: true,
+		}
+		_, err := client.Workspaces.ListRemoteStateConsumersWithDetails(ctx, "ws-source", options)
+		require.NoError(t, err)
+		require.Len(t, queries, 2)
+		for _, q := range queries {
+			assert.Contains(t, q, "fields%5Bworkspaces%5D=")
+		}
+
+		// The options of the caller are left as is.
+		assert.Equal(t, 0, options.PageNumber)
+	})
+}
+
 func TestWorkspaces_RemoveRemoteStateConsumers(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -2262,6 +2565,57 @@ func TestWorkspaces_UpdateRemoteStateConsumers(t *testing.T) {
 	})
 }
 
+func TestWorkspaces_MigrateRemoteStateSharing(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	wTest, wTestCleanup := createWorkspace(t, client, orgTest)
+	t.Cleanup(wTestCleanup)
+
+	wTestConsumer1, wTestCleanupConsumer1 := createWorkspace(t, client, orgTest)
+	t.Cleanup(wTestCleanupConsumer1)
+	wTestConsumer2, wTestCleanupConsumer2 := createWorkspace(t, client, orgTest)
+	t.Cleanup(wTestCleanupConsumer2)
+
+	_, err := client.Workspaces.UpdateByID(ctx, wTest.ID, WorkspaceUpdateOptions{
+		GlobalRemoteState: Bool(true),
+	})
+	require.NoError(t, err)
+
+	t.Run("when the workspace shares its state globally", func(t *testing.T) {
+		migration, err := client.Workspaces.MigrateRemoteStateSharing(ctx, wTest.ID)
+		require.NoError(t, err)
+		assert.True(t, migration.Migrated)
+		assert.Len(t, migration.AddedConsumers, 2)
+
+		w, err := client.Workspaces.ReadByID(ctx, wTest.ID)
+		require.NoError(t, err)
+		assert.False(t, w.GlobalRemoteState)
+
+		rsc, err := client.Workspaces.ListRemoteStateConsumers(ctx, wTest.ID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(rsc.Items))
+		assert.Contains(t, rsc.Items, wTestConsumer1)
+		assert.Contains(t, rsc.Items, wTestConsumer2)
+	})
+
+	t.Run("when the workspace already uses explicit consumers", func(t *testing.T) {
+		migration, err := client.Workspaces.MigrateRemoteStateSharing(ctx, wTest.ID)
+		require.NoError(t, err)
+		assert.False(t, migration.Migrated)
+		assert.Empty(t, migration.AddedConsumers)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		migration, err := client.Workspaces.MigrateRemoteStateSharing(ctx, badIdentifier)
+		assert.Nil(t, migration)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
 func TestWorkspaces_AddTags(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -2451,16 +2805,519 @@ func TestWorkspaces_RemoveTags(t *testing.T) {
 	})
 }
 
+func TestWorkspaces_TagsByFilter(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	wTest1, wTest1Cleanup := createWorkspaceWithOptions(t, client, orgTest, WorkspaceCreateOptions{
+		Name: String("bulk-tags-1"),
+	})
+	t.Cleanup(wTest1Cleanup)
+
+	wTest2, wTest2Cleanup := createWorkspaceWithOptions(t, client, orgTest, WorkspaceCreateOptions{
+		Name: String("bulk-tags-2"),
+	})
+	t.Cleanup(wTest2Cleanup)
+
+	_, wTest3Cleanup := createWorkspaceWithOptions(t, client, orgTest, WorkspaceCreateOptions{
+		Name: String("other-workspace"),
+	})
+	t.Cleanup(wTest3Cleanup)
+
+	filter := WorkspaceListOptions{
+		Search: "bulk-tags",
+	}
+
+	t.Run("with a dry run", func(t *testing.T) {
+		results, err := client.Workspaces.AddTagsByFilter(ctx, orgTest.Name, filter, []string{"cost-center"}, &WorkspaceTagsByFilterOptions{
+			DryRun: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+
+		for _, r := range results {
+			assert.False(t, r.Applied)
+			assert.NoError(t, r.Err)
+
+			w, err := client.Workspaces.ReadByID(ctx, r.Workspace.ID)
+			require.NoError(t, err)
+			assert.Empty(t, w.TagNames)
+		}
+	})
+
+	t.Run("successfully adds tags", func(t *testing.T) {
+		results, err := client.Workspaces.AddTagsByFilter(ctx, orgTest.Name, filter, []string{"cost-center"}, nil)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+
+		for _, r := range results {
+			assert.True(t, r.Applied)
+			assert.NoError(t, r.Err)
+		}
+
+		for _, wTest := range []*Workspace{wTest1, wTest2} {
+			w, err := client.Workspaces.ReadByID(ctx, wTest.ID)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"cost-center"}, w.TagNames)
+		}
+	})
+
+	t.Run("successfully removes tags", func(t *testing.T) {
+		results, err := client.Workspaces.RemoveTagsByFilter(ctx, orgTest.Name, filter, []string{"cost-center"}, nil)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+
+		for _, wTest := range []*Workspace{wTest1, wTest2} {
+			w, err := client.Workspaces.ReadByID(ctx, wTest.ID)
+			require.NoError(t, err)
+			assert.Empty(t, w.TagNames)
+		}
+	})
+
+	t.Run("without tags", func(t *testing.T) {
+		results, err := client.Workspaces.AddTagsByFilter(ctx, orgTest.Name, filter, []string{}, nil)
+		assert.Nil(t, results)
+		assert.EqualError(t, err, ErrMissingTagIdentifier.Error())
+	})
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		results, err := client.Workspaces.AddTagsByFilter(ctx, badIdentifier, filter, []string{"cost-center"}, nil)
+		assert.Nil(t, results)
+		assert.EqualError(t, err, ErrInvalidOrg.Error())
+	})
+}
+
+func TestWorkspaces_TagsByFilter_Pages(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	tagged := []string{"ws-1", "ws-2", "ws-3"}
+	var removed []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch {
+		case r.URL.Path == "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "GET" && r.URL.Path == "/api/v2/organizations/my-org/workspaces":
+			assert.Equal(t, "old", r.URL.Query().Get("search[tags]"))
+			page, _ := strconv.Atoi(r.URL.Query().Get("page[number]"))
+			if page == 0 {
+				page = 1
+			}
+
+			// One workspace per page, among the ones still tagged.
+			data, next := "", 0
+			if page <= len(tagged) {
+				data = fmt.Sprintf(`{"id":%q,"type":"workspaces","attributes":{"name":%q}}`, tagged[page-1], tagged[page-1])
+			}
+			if page < len(tagged) {
+				next = page + 1
+			}
+			fmt.Fprintf(w, `{"data":[%s],"meta":{"pagination":{"current-page":%d,"next-page":%d,"total-pages":%d,"total-count":%d}}}`,
+				data, page, next, len(tagged), len(tagged))
+		case r.Method == "DELETE" && strings.HasSuffix(r.URL.Path, "/relationships/tags"):
+			id := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v2/workspaces/"), "/")[0]
+			removed = append(removed, id)
+			for i, tid := range tagged {
+				if tid == id {
+					tagged = append(tagged[:i], tagged[i+1:]...)
+					break
+				}
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+
+	results, err := client.Workspaces.RemoveTagsByFilter(ctx, "my-org", WorkspaceListOptions{Tags: "old"}, []string{"old"}, nil)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	for _, result := range results {
+		assert.True(t, result.Applied)
+		assert.NoError(t, result.Err)
+	}
+	assert.Equal(t, []string{"ws-1", "ws-2", "ws-3"}, removed)
+}
+
+func TestWorkspacesListPolicySets(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	upgradeOrganizationSubscription(t, client, orgTest)
+
+	prjTest, prjTestCleanup := createProject(t, client, orgTest)
+	t.Cleanup(prjTestCleanup)
+
+	wTest, wTestCleanup := createWorkspaceWithOptions(t, client, orgTest, WorkspaceCreateOptions{
+		Name:    String(randomString(t)),
+		Project: prjTest,
+	})
+	t.Cleanup(wTestCleanup)
+
+	pTest, pTestCleanup := createPolicy(t, client, orgTest)
+	t.Cleanup(pTestCleanup)
+
+	psDirect, psDirectCleanup := createPolicySet(t, client, orgTest, []*Policy{pTest}, []*Workspace{wTest}, nil, nil, Sentinel)
+	t.Cleanup(psDirectCleanup)
+
+	psProject, psProjectCleanup := createPolicySet(t, client, orgTest, nil, nil, nil, []*Project{prjTest}, Sentinel)
+	t.Cleanup(psProjectCleanup)
+
+	_, psExcludedCleanup := createPolicySet(t, client, orgTest, nil, nil, []*Workspace{wTest}, []*Project{prjTest}, Sentinel)
+	t.Cleanup(psExcludedCleanup)
+
+	t.Run("lists the policy sets enforced on the workspace", func(t *testing.T) {
+		wpsl, err := client.Workspaces.ListPolicySets(ctx, wTest.ID, nil)
+		require.NoError(t, err)
+		require.Len(t, wpsl, 2)
+
+		sources := make(map[string]PolicySetSource)
+		for _, wps := range wpsl {
+			sources[wps.PolicySet.ID] = wps.Source
+			if wps.PolicySet.ID == psDirect.ID {
+				assert.Equal(t, []EnforcementLevel{EnforcementSoft}, wps.EnforcementLevels)
+			}
+		}
+		assert.Equal(t, map[string]PolicySetSource{
+			psDirect.ID:  PolicySetSourceWorkspace,
+			psProject.ID: PolicySetSourceProject,
+		}, sources)
+	})
+
+	t.Run("with a search filter", func(t *testing.T) {
+		wpsl, err := client.Workspaces.ListPolicySets(ctx, wTest.ID, &PolicySetListOptions{
+			Search: psProject.Name,
+		})
+		require.NoError(t, err)
+		require.Len(t, wpsl, 1)
+		assert.Equal(t, psProject.ID, wpsl[0].PolicySet.ID)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		wpsl, err := client.Workspaces.ListPolicySets(ctx, badIdentifier, nil)
+		assert.Nil(t, wpsl)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
+func TestWorkspacesListPolicySets_Include(t *testing.T) {
+	ctx := context.Background()
+
+	var include string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(204)
+		case "/api/v2/workspaces/ws-123":
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","relationships":{"organization":{"data":{"id":"my-org","type":"organizations"}}}}}`)
+		case "/api/v2/organizations/my-org/policy-sets":
+			include = r.URL.Query().Get("include")
+			fmt.Fprint(w, `{"data":[]}`)
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "abcd1234",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	options := &PolicySetListOptions{
+		Include: []PolicySetIncludeOpt{PolicySetCurrentVersion, PolicySetPolicies},
+	}
+	_, err = client.Workspaces.ListPolicySets(ctx, "ws-123", options)
+	require.NoError(t, err)
+
+	assert.Equal(t, "current_version,policies,projects,workspaces,workspace_exclusions", include)
+	assert.Equal(t, []PolicySetIncludeOpt{PolicySetCurrentVersion, PolicySetPolicies}, options.Include)
+}
+
+func TestWorkspacesReadRunStatistics(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	t.Cleanup(wTestCleanup)
+
+	_, rTestCleanup := createRun(t, client, wTest)
+	t.Cleanup(rTestCleanup)
+
+	t.Run("with default options", func(t *testing.T) {
+		stats, err := client.Workspaces.ReadRunStatistics(ctx, wTest.ID, RunStatisticsOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, 30, stats.Days)
+		assert.Equal(t, 1, stats.TotalRuns)
+	})
+
+	t.Run("with a number of days", func(t *testing.T) {
+		stats, err := client.Workspaces.ReadRunStatistics(ctx, wTest.ID, RunStatisticsOptions{Days: 7})
+		require.NoError(t, err)
+		assert.Equal(t, 7, stats.Days)
+		assert.Equal(t, 1, stats.TotalRuns)
+	})
+
+	t.Run("with a negative number of days", func(t *testing.T) {
+		stats, err := client.Workspaces.ReadRunStatistics(ctx, wTest.ID, RunStatisticsOptions{Days: -1})
+		assert.Nil(t, stats)
+		assert.Equal(t, err, ErrInvalidRunStatisticsDays)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		stats, err := client.Workspaces.ReadRunStatistics(ctx, badIdentifier, RunStatisticsOptions{})
+		assert.Nil(t, stats)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
+func TestWorkspacesReadCurrentRun(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	t.Cleanup(wTestCleanup)
+
+	t.Run("without a current run", func(t *testing.T) {
+		r, err := client.Workspaces.ReadCurrentRun(ctx, wTest.ID)
+		assert.Nil(t, r)
+		assert.Equal(t, ErrNoCurrentRun, err)
+	})
+
+	t.Run("with a current run", func(t *testing.T) {
+		rTest, rTestCleanup := createRun(t, client, wTest)
+		t.Cleanup(rTestCleanup)
+
+		r, err := client.Workspaces.ReadCurrentRun(ctx, wTest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, rTest.ID, r.ID)
+		assert.NotEmpty(t, r.Status)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		r, err := client.Workspaces.ReadCurrentRun(ctx, badIdentifier)
+		assert.Nil(t, r)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
+func TestWorkspacesListNotificationConfigurations(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	defer wTestCleanup()
+
+	ncTest, ncTestCleanup := createNotificationConfiguration(t, client, wTest, nil)
+	defer ncTestCleanup()
+
+	t.Run("with a notification configuration", func(t *testing.T) {
+		ncl, err := client.Workspaces.ListNotificationConfigurations(ctx, wTest.ID, nil)
+		require.NoError(t, err)
+		require.Len(t, ncl.Items, 1)
+
+		assert.Equal(t, ncTest.ID, ncl.Items[0].ID)
+		assert.Equal(t, ncTest.Triggers, ncl.Items[0].Triggers)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		ncl, err := client.Workspaces.ListNotificationConfigurations(ctx, badIdentifier, nil)
+		assert.Nil(t, ncl)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
+func TestWorkspacesLatestSuccessfulRun(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	t.Cleanup(wTestCleanup)
+
+	t.Run("without successful runs", func(t *testing.T) {
+		r, err := client.Workspaces.LatestSuccessfulRun(ctx, wTest.ID)
+		assert.Nil(t, r)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with an applied run", func(t *testing.T) {
+		rTest, rTestCleanup := createRunApply(t, client, wTest)
+		t.Cleanup(rTestCleanup)
+
+		r, err := client.Workspaces.LatestSuccessfulRun(ctx, wTest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, rTest.ID, r.ID)
+		assert.Equal(t, RunApplied, r.Status)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		r, err := client.Workspaces.LatestSuccessfulRun(ctx, badIdentifier)
+		assert.Nil(t, r)
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+	})
+}
+
+func TestWorkspacesLatestSuccessfulRun_Filter(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		assert.Equal(t, "applied,planned_and_finished", r.URL.Query().Get("filter[status]"))
+		assert.Equal(t, "1", r.URL.Query().Get("page[size]"))
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+
+		switch r.URL.Path {
+		case "/api/v2/workspaces/ws-123/runs":
+			fmt.Fprint(w, `{"data":[{"id":"run-2","type":"runs","attributes":{"status":"planned_and_finished"}}],"meta":{"pagination":{"current-page":1,"next-page":2,"total-pages":2}}}`)
+		case "/api/v2/workspaces/ws-empty/runs":
+			fmt.Fprint(w, `{"data":[],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	r, err := client.Workspaces.LatestSuccessfulRun(ctx, "ws-123")
+	require.NoError(t, err)
+	assert.Equal(t, "run-2", r.ID)
+	assert.Equal(t, RunPlannedAndFinished, r.Status)
+
+	r, err = client.Workspaces.LatestSuccessfulRun(ctx, "ws-empty")
+	assert.Nil(t, r)
+	assert.Equal(t, ErrResourceNotFound, err)
+}
+
+func TestNewRunStatistics(t *testing.T) {
+	start := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	runs := []*Run{
+		{
+			Status: RunApplied,
+			StatusTimestamps: &RunStatusTimestamps{
+				PlanningAt: start,
+				PlannedAt:  start.Add(time.Minute),
+				ApplyingAt: start.Add(2 * time.Minute),
+				AppliedAt:  start.Add(5 * time.Minute),
+			},
+		},
+		{
+			Status: RunPlannedAndFinished,
+			StatusTimestamps: &RunStatusTimestamps{
+				PlanningAt:           start,
+				PlannedAndFinishedAt: start.Add(3 * time.Minute),
+			},
+		},
+		{
+			Status: RunErrored,
+			StatusTimestamps: &RunStatusTimestamps{
+				PlanningAt: start,
+				ErroredAt:  start.Add(time.Minute),
+			},
+		},
+		{
+			Status: RunPlanning,
+			StatusTimestamps: &RunStatusTimestamps{
+				PlanningAt: start,
+			},
+		},
+		{
+			Status: RunDiscarded,
+		},
+	}
+
+	stats := newRunStatistics(runs)
+	assert.Equal(t, 5, stats.TotalRuns)
+	assert.Equal(t, 2, stats.SuccessfulRuns)
+	assert.Equal(t, 1, stats.FailedRuns)
+	assert.InDelta(t, 2.0/3.0, stats.SuccessRate, 0.0001)
+	assert.InDelta(t, 1.0/3.0, stats.FailureRate, 0.0001)
+	assert.Equal(t, 2*time.Minute, stats.AveragePlanDuration)
+	assert.Equal(t, 3*time.Minute, stats.AverageApplyDuration)
+
+	t.Run("without runs", func(t *testing.T) {
+		stats := newRunStatistics(nil)
+		assert.Equal(t, &RunStatistics{}, stats)
+	})
+}
+
+func TestWorkspacePolicySetSource(t *testing.T) {
+	ws := &Workspace{ID: "ws-1"}
+	prj := &Project{ID: "prj-1"}
+
+	testCases := map[string]struct {
+		policySet *PolicySet
+		source    PolicySetSource
+		enforced  bool
+	}{
+		"attached to the workspace": {
+			policySet: &PolicySet{Workspaces: []*Workspace{ws}},
+			source:    PolicySetSourceWorkspace,
+			enforced:  true,
+		},
+		"attached to the project": {
+			policySet: &PolicySet{Projects: []*Project{prj}},
+			source:    PolicySetSourceProject,
+			enforced:  true,
+		},
+		"global": {
+			policySet: &PolicySet{Global: true},
+			source:    PolicySetSourceGlobal,
+			enforced:  true,
+		},
+		"global with the workspace excluded": {
+			policySet: &PolicySet{Global: true, WorkspaceExclusions: []*Workspace{ws}},
+		},
+		"attached to the project with the workspace excluded": {
+			policySet: &PolicySet{Projects: []*Project{prj}, WorkspaceExclusions: []*Workspace{ws}},
+		},
+		"attached to other resources": {
+			policySet: &PolicySet{Workspaces: []*Workspace{{ID: "ws-2"}}, Projects: []*Project{{ID: "prj-2"}}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			source, enforced := workspacePolicySetSource(tc.policySet, ws.ID, prj.ID)
+			assert.Equal(t, tc.enforced, enforced)
+			assert.Equal(t, tc.source, source)
+		})
+	}
+}
+
 func TestWorkspace_Unmarshal(t *testing.T) {
 	data := map[string]interface{}{
 		"data": map[string]interface{}{
 			"type": "workspaces",
 			"id":   "ws-1234",
 			"attributes": map[string]interface{}{
-				"name":           "my-workspace",
-				"auto-apply":     true,
-				"created-at":     "2020-07-15T23:38:43.821Z",
-				"resource-count": 2,
+				"name":                   "my-workspace",
+				"auto-apply":             true,
+				"auto-apply-run-trigger": true,
+				"created-at":             "2020-07-15T23:38:43.821Z",
+				"resource-count":         2,
 				"permissions": map[string]interface{}{
 					"can-update": true,
 					"can-lock":   true,
@@ -2480,6 +3337,10 @@ func TestWorkspace_Unmarshal(t *testing.T) {
 				},
 				"trigger-prefixes": []string{"prefix-"},
 				"trigger-patterns": []string{"pattern1/**/*", "pattern2/**/submodule/*"},
+				"setting-overwrites": map[string]interface{}{
+					"execution-mode": true,
+					"agent-pool":     false,
+				},
 			},
 		},
 	}
@@ -2499,6 +3360,7 @@ func TestWorkspace_Unmarshal(t *testing.T) {
 	assert.Equal(t, ws.ID, "ws-1234")
 	assert.Equal(t, ws.Name, "my-workspace")
 	assert.Equal(t, ws.AutoApply, true)
+	assert.Equal(t, ws.AutoApplyRunTrigger, true)
 	assert.Equal(t, ws.CreatedAt, parsedTime)
 	assert.Equal(t, ws.ResourceCount, 2)
 	assert.Equal(t, ws.Permissions.CanUpdate, true)
@@ -2514,6 +3376,8 @@ func TestWorkspace_Unmarshal(t *testing.T) {
 	assert.Equal(t, ws.Actions.IsDestroyable, true)
 	assert.Equal(t, ws.TriggerPrefixes, []string{"prefix-"})
 	assert.Equal(t, ws.TriggerPatterns, []string{"pattern1/**/*", "pattern2/**/submodule/*"})
+	assert.Equal(t, ws.SettingOverwrites.ExecutionMode, Bool(true))
+	assert.Equal(t, ws.SettingOverwrites.AgentPool, Bool(false))
 }
 
 func TestWorkspaceCreateOptions_Marshal(t *testing.T) {
@@ -2540,6 +3404,63 @@ func TestWorkspaceCreateOptions_Marshal(t *testing.T) {
 	assert.Equal(t, expectedBody, string(bodyBytes))
 }
 
+func TestWorkspaceUpdateOptions_Marshal(t *testing.T) {
+	t.Run("when clearing the setting overwrites", func(t *testing.T) {
+		opts := WorkspaceUpdateOptions{
+			SettingOverwrites: &WorkspaceSettingOverwritesOptions{
+				ExecutionMode: Bool(false),
+				AgentPool:     Bool(false),
+			},
+		}
+
+		reqBody, err := serializeRequestBody(&opts)
+		require.NoError(t, err)
+		req, err := retryablehttp.NewRequest("PATCH", "url", reqBody)
+		require.NoError(t, err)
+		bodyBytes, err := req.BodyBytes()
+		require.NoError(t, err)
+
+		expectedBody := `{"data":{"type":"workspaces","attributes":{"setting-overwrites":{"execution-mode":false,"agent-pool":false}}}}
+`
+		assert.Equal(t, expectedBody, string(bodyBytes))
+	})
+
+	t.Run("without setting overwrites", func(t *testing.T) {
+		opts := WorkspaceUpdateOptions{
+			ExecutionMode: String("local"),
+		}
+
+		reqBody, err := serializeRequestBody(&opts)
+		require.NoError(t, err)
+		req, err := retryablehttp.NewRequest("PATCH", "url", reqBody)
+		require.NoError(t, err)
+		bodyBytes, err := req.BodyBytes()
+		require.NoError(t, err)
+
+		expectedBody := `{"data":{"type":"workspaces","attributes":{"execution-mode":"local"}}}
+`
+		assert.Equal(t, expectedBody, string(bodyBytes))
+	})
+
+	t.Run("when disabling auto-apply for run triggers only", func(t *testing.T) {
+		opts := WorkspaceUpdateOptions{
+			AutoApply:           Bool(true),
+			AutoApplyRunTrigger: Bool(false),
+		}
+
+		reqBody, err := serializeRequestBody(&opts)
+		require.NoError(t, err)
+		req, err := retryablehttp.NewRequest("PATCH", "url", reqBody)
+		require.NoError(t, err)
+		bodyBytes, err := req.BodyBytes()
+		require.NoError(t, err)
+
+		expectedBody := `{"data":{"type":"workspaces","attributes":{"auto-apply":true,"auto-apply-run-trigger":false}}}
+`
+		assert.Equal(t, expectedBody, string(bodyBytes))
+	})
+}
+
 func TestWorkspacesRunTasksPermission(t *testing.T) {
 	skipUnlessBeta(t)
 
@@ -2692,3 +3613,106 @@ func TestWorkspacesAutoDestroy(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, w.AutoDestroyAt)
 }
+
+func TestWorkspacesTransferToOrganization(t *testing.T) {
+	client := &Client{}
+	client.Workspaces = &workspaces{client: client}
+	ctx := context.Background()
+
+	t.Run("with valid identifiers", func(t *testing.T) {
+		w, err := client.Workspaces.TransferToOrganization(ctx, "ws-123", "target-org", WorkspaceTransferOptions{})
+		assert.Nil(t, w)
+		assert.ErrorIs(t, err, ErrNotSupported)
+		assert.Equal(t, ErrWorkspaceTransferNotSupported, err)
+	})
+
+	t.Run("with an invalid workspace ID", func(t *testing.T) {
+		_, err := client.Workspaces.TransferToOrganization(ctx, badIdentifier, "target-org", WorkspaceTransferOptions{})
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+	})
+
+	t.Run("with an invalid target organization", func(t *testing.T) {
+		_, err := client.Workspaces.TransferToOrganization(ctx, "ws-123", badIdentifier, WorkspaceTransferOptions{})
+		assert.Equal(t, ErrInvalidOrg, err)
+	})
+}
+
+func TestWorkspacesReadByIDWithOptions_IfNoneMatch(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		if r.Header.Get("If-None-Match") == `W/"abc"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		w.Header().Set("ETag", `W/"abc"`)
+		fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	var etag string
+	_, err = client.Workspaces.ReadByID(ContextWithETag(ctx, &etag), "ws-123")
+	require.NoError(t, err)
+	assert.Equal(t, `W/"abc"`, etag)
+
+	t.Run("when the workspace has not changed", func(t *testing.T) {
+		cached, err := client.Workspaces.ReadByIDWithOptions(ctx, "ws-123", &WorkspaceReadOptions{IfNoneMatch: etag})
+		assert.Nil(t, cached)
+		assert.Equal(t, ErrNotModified, err)
+
+		cached, err = client.Workspaces.ReadWithOptions(ctx, "my-org", "my-workspace", &WorkspaceReadOptions{IfNoneMatch: etag})
+		assert.Nil(t, cached)
+		assert.Equal(t, ErrNotModified, err)
+	})
+
+	t.Run("without an ETag", func(t *testing.T) {
+		w, err := client.Workspaces.Read(ctx, "my-org", "my-workspace")
+		require.NoError(t, err)
+		assert.Equal(t, "my-workspace", w.Name)
+	})
+}
+
+func TestWorkspacesReadByIDWithOptions_LockedBy(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		assert.Equal(t, "locked_by", r.URL.Query().Get("include"))
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		fmt.Fprint(w, `{
+			"data": {"id": "ws-123", "type": "workspaces",
+				"attributes": {"name": "my-workspace", "locked": true, "locked-reason": "Applying a hotfix"},
+				"relationships": {"locked-by": {"data": {"id": "run-123", "type": "runs"}}}},
+			"included": [{"id": "run-123", "type": "runs", "attributes": {"status": "applying"}}]
+		}`)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(&Config{Address: ts.URL, Token: "abcd1234", HTTPClient: ts.Client()})
+	require.NoError(t, err)
+
+	w, err := client.Workspaces.ReadByIDWithOptions(context.Background(), "ws-123", &WorkspaceReadOptions{
+		Include: []WSIncludeOpt{WSLockedBy},
+	})
+	require.NoError(t, err)
+	assert.True(t, w.Locked)
+	assert.Equal(t, "Applying a hotfix", w.LockedReason)
+
+	require.NotNil(t, w.LockedBy)
+	require.NotNil(t, w.LockedBy.Run)
+	assert.Nil(t, w.LockedBy.User)
+	assert.Nil(t, w.LockedBy.Team)
+	assert.Equal(t, "run-123", w.LockedBy.Run.ID)
+	assert.Equal(t, RunApplying, w.LockedBy.Run.Status)
+}